@@ -0,0 +1,80 @@
+package vercelgo
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"iter"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// API groups every resource interface implemented by VercelClient.
+// Depend on it, or on a single resource interface, to substitute mocks or decorators for the client.
+// The interfaces only list the context-aware methods of the client.
+type API interface {
+	ProjectsAPI
+	TeamsAPI
+	DomainsAPI
+	DeploymentsAPI
+}
+
+// ProjectsAPI is the project management part of the Vercel API.
+type ProjectsAPI interface {
+	CreateProjectCtx(ctx context.Context, payload schemas.CreateProjectRequest, teamId string, framework schemas.VercelFramework) (*schemas.Project, error)
+	UpdateProjectCtx(ctx context.Context, projectIdOrName string, payload schemas.CreateProjectRequest, teamId string) (*schemas.Project, error)
+	DeleteProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error
+	PauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error
+	UnpauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error
+	IterProjects(ctx context.Context, teamId string, filter *schemas.Filter) iter.Seq2[schemas.Project, error]
+	ListProjectsAll(ctx context.Context, teamId string, filter *schemas.Filter) ([]schemas.Project, error)
+}
+
+// TeamsAPI is the team management part of the Vercel API.
+type TeamsAPI interface {
+	GetTeamCtx(ctx context.Context, teamId string) (*schemas.Team, error)
+	ListTeamsCtx(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error)
+	IterTeams(ctx context.Context, filter *schemas.Filter) iter.Seq2[schemas.Team, error]
+	ListTeamsAll(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error)
+	CreateTeamCtx(ctx context.Context, slug, name string) (string, error)
+	UpdateTeamCtx(ctx context.Context, teamId, name, slug string) (*schemas.Team, error)
+	DeleteTeamCtx(ctx context.Context, teamId string, reasons []schemas.Reason) error
+}
+
+// DomainsAPI is the project domain part of the Vercel API.
+type DomainsAPI interface {
+	AddProjectDomainCtx(ctx context.Context, domainName, teamId, projectIdOrName string) (*schemas.AllDomainWithVerification, error)
+	DeleteProjectDomainCtx(ctx context.Context, domainName, projectIdOrName, teamId string) (*schemas.AllDomainWithVerification, error)
+	GetProjectDomainsCtx(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) (*schemas.AllDomainWithVerification, error)
+	IterProjectDomains(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) iter.Seq2[schemas.DomainInfo, error]
+	ListProjectDomainsAll(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) ([]schemas.DomainInfo, error)
+	GetDomainConfigCtx(ctx context.Context, domainName, teamId string) (*schemas.DomainConfigInfo, error)
+	ForceDNSVerificationCtx(ctx context.Context, domainName, projectId, teamId string) (*schemas.ProjectDomanVerification, error)
+}
+
+// DeploymentsAPI is the deployment part of the Vercel API.
+type DeploymentsAPI interface {
+	DeployCtx(ctx context.Context, projectId, deploymentName, directory, teamId, target string, opts ...DeployOption) (*schemas.AllDomainWithVerification, string, error)
+	DeployDir(ctx context.Context, projectId, deploymentName, directory, teamId, target string, opts ...DeployOption) (*DeployResult, error)
+	DeployFS(ctx context.Context, projectId, deploymentName string, fsys fs.FS, teamId, target string, opts ...DeployOption) (*DeployResult, error)
+	DeployWithOptions(ctx context.Context, req schemas.CreateDeploymentRequest, fsys fs.FS, teamId string, opts ...DeployOption) (*DeployResult, error)
+	DeployGitSource(ctx context.Context, projectId, deploymentName string, source schemas.GitSource, teamId, target string) (*schemas.DeploymentResponse, error)
+	DeployFiles(ctx context.Context, projectId, deploymentName string, files []DeployFile, teamId, target string, opts ...DeployOption) (*DeployResult, error)
+	GetDeploymentsCtx(ctx context.Context, projectId, teamId string) ([]schemas.DeploymentResponse, error)
+	IterDeployments(ctx context.Context, projectId, teamId string) iter.Seq2[schemas.DeploymentResponse, error]
+	ListDeploymentsAll(ctx context.Context, projectId, teamId string) ([]schemas.DeploymentResponse, error)
+	GetDeploymentStatusCtx(ctx context.Context, deploymentId, teamId string) (*schemas.DeploymentStatus, error)
+	WaitForDeploymentCtx(ctx context.Context, deploymentId, teamId string, timeout time.Duration) (*schemas.DeploymentStatus, error)
+	WaitDeployment(ctx context.Context, deploymentId, teamId string, opts ...WaitOption) (*schemas.DeploymentStatus, error)
+	DeleteDeploymentCtx(ctx context.Context, deploymentId, teamId string) error
+	GetCurrentDeploymentCtx(ctx context.Context, projectId, teamId string) (*schemas.CurrentDeployment, error)
+	CleanDeploymentsCtx(ctx context.Context, projectId, teamId string) error
+	GetDeploymentLogsCtx(ctx context.Context, projectId, teamId string) ([]schemas.DeployLogsResponse, error)
+	IterDeploymentLogs(ctx context.Context, deploymentId, teamId string, opts *schemas.DeploymentLogsOptions) iter.Seq2[schemas.DeployLogsResponse, error]
+	ListDeploymentLogsAll(ctx context.Context, deploymentId, teamId string, opts *schemas.DeploymentLogsOptions) ([]schemas.DeployLogsResponse, error)
+	StreamDeploymentEvents(ctx context.Context, deploymentId, teamId string) iter.Seq2[schemas.DeployLogsResponse, error]
+	ExportDeploymentLogs(ctx context.Context, w io.Writer, deploymentId, teamId string, format LogFormat, opts *schemas.DeploymentLogsOptions) error
+}
+
+var _ API = (*VercelClient)(nil)
@@ -1,5 +1,6 @@
 package config
 
 const (
+	// BaseURL is the default Vercel API base URL. Clients can override it with vercelgo.WithBaseURL.
 	BaseURL = "https://api.vercel.com"
 )
@@ -0,0 +1,215 @@
+package vercelgo
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// DeployResult describes a deployment created by DeployDir, DeployFS or DeployFiles.
+type DeployResult struct {
+	// Deployment is the deployment returned by Vercel when it was created.
+	Deployment schemas.DeploymentResponse
+	// Domains are the domains of the project, only looked up with WithDomainLookup.
+	Domains *schemas.AllDomainWithVerification
+
+	// Files and Bytes count every file of the deployment.
+	Files int
+	Bytes int64
+	// UploadedFiles and UploadedBytes count the files that had to be uploaded.
+	UploadedFiles int
+	UploadedBytes int64
+	// SkippedFiles counts the files Vercel already had.
+	SkippedFiles int
+
+	Timings DeployTimings
+}
+
+// DeployTimings are the durations of the steps of a deployment.
+type DeployTimings struct {
+	// Collect is the time spent listing the files, Hash the time spent hashing them.
+	Collect time.Duration
+	Hash    time.Duration
+	// Upload is the time spent uploading the missing files.
+	Upload time.Duration
+	// Create is the time spent in deployment creation requests.
+	Create time.Duration
+	// DomainLookup is the time spent looking up the project domains.
+	DomainLookup time.Duration
+	// Total is the duration of the whole deployment.
+	Total time.Duration
+}
+
+// Deploy uploads files to Vercel from a directory and creates a deployment for the specified project.
+// Files are selected according to DefaultIgnorePatterns, the .vercelignore file of the directory and opts.
+//
+// Deploy is kept for compatibility: DeployDir returns the whole deployment and only looks up
+// the project domains on request.
+func (c *VercelClient) Deploy(projectId, deploymentName, directory, teamId, target string, opts ...DeployOption) (*schemas.AllDomainWithVerification, string, error) {
+	return c.DeployCtx(context.Background(), projectId, deploymentName, directory, teamId, target, opts...)
+}
+
+// DeployCtx is like Deploy but uses ctx for every request it makes.
+func (c *VercelClient) DeployCtx(ctx context.Context, projectId, deploymentName, directory, teamId, target string, opts ...DeployOption) (*schemas.AllDomainWithVerification, string, error) {
+	result, err := c.DeployDir(ctx, projectId, deploymentName, directory, teamId, target, append([]DeployOption{WithDomainLookup()}, opts...)...)
+	if err != nil {
+		return nil, "", err
+	}
+	return result.Domains, result.Deployment.Id, nil
+}
+
+// DeployDir uploads files to Vercel from a directory and creates a deployment for the specified project.
+// Files are selected according to DefaultIgnorePatterns, the .vercelignore file of the directory and opts.
+func (c *VercelClient) DeployDir(ctx context.Context, projectId, deploymentName, directory, teamId, target string, opts ...DeployOption) (*DeployResult, error) {
+	return c.DeployFS(ctx, projectId, deploymentName, os.DirFS(directory), teamId, target, opts...)
+}
+
+// DeployFS is like DeployDir but deploys the files of fsys, such as an embed.FS, an fstest.MapFS
+// or a *zip.Reader. Ignore files are read from the root of fsys.
+func (c *VercelClient) DeployFS(ctx context.Context, projectId, deploymentName string, fsys fs.FS, teamId, target string, opts ...DeployOption) (*DeployResult, error) {
+	req := schemas.CreateDeploymentRequest{
+		Name:    deploymentName,
+		Project: projectId,
+		Target:  target,
+	}
+	return c.DeployWithOptions(ctx, req, fsys, teamId, opts...)
+}
+
+// DeployWithOptions is like DeployFS but creates the deployment described by req, giving access
+// to every field of the deployment creation body such as env variables, meta tags, regions
+// and project settings. The Files of req are replaced with the files collected from fsys.
+func (c *VercelClient) DeployWithOptions(ctx context.Context, req schemas.CreateDeploymentRequest, fsys fs.FS, teamId string, opts ...DeployOption) (*DeployResult, error) {
+	cfg := newDeployConfig(opts)
+	return c.deploy(ctx, teamId, req, cfg, func() ([]deployFile, error) {
+		files, err := collectFiles(fsys, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed walking files: %w", err)
+		}
+		return files, nil
+	})
+}
+
+// DeployFile is a file given to DeployFiles.
+type DeployFile struct {
+	// Name is the slash-separated path of the file in the deployment, e.g. "docs/index.html".
+	Name string
+	// Data is the content of the file. It is ignored when Open is set.
+	Data []byte
+	// Open returns a new reader of the content of the file. It may be called more than once:
+	// to hash the file and again when the file has to be uploaded.
+	Open func() (io.ReadCloser, error)
+}
+
+// DeployFiles is like DeployDir but deploys exactly the given files: ignore patterns do not apply to them.
+func (c *VercelClient) DeployFiles(ctx context.Context, projectId, deploymentName string, files []DeployFile, teamId, target string, opts ...DeployOption) (*DeployResult, error) {
+	req := schemas.CreateDeploymentRequest{
+		Name:    deploymentName,
+		Project: projectId,
+		Target:  target,
+	}
+	return c.deploy(ctx, teamId, req, newDeployConfig(opts), func() ([]deployFile, error) {
+		return listedFiles(files)
+	})
+}
+
+// DeployGitSource creates a deployment for the specified project built by Vercel from a commit
+// of a connected Git repository, without uploading any file.
+func (c *VercelClient) DeployGitSource(ctx context.Context, projectId, deploymentName string, source schemas.GitSource, teamId, target string) (*schemas.DeploymentResponse, error) {
+	req := schemas.CreateDeploymentRequest{
+		Name:      deploymentName,
+		Project:   projectId,
+		Target:    target,
+		GitSource: &source,
+	}
+	return c.createDeployment(ctx, "DeployGitSource", c.team(teamId), req)
+}
+
+// deploy collects and hashes the files, uploads the ones Vercel does not have yet
+// and creates the deployment described by deploymentReq with them.
+func (c *VercelClient) deploy(ctx context.Context, teamId string, deploymentReq schemas.CreateDeploymentRequest, cfg *deployConfig, collect func() ([]deployFile, error)) (*DeployResult, error) {
+	teamId = c.team(teamId)
+	result := &DeployResult{}
+	start := time.Now()
+	defer func() { result.Timings.Total = time.Since(start) }()
+
+	files, err := collect()
+	if err != nil {
+		return nil, err
+	}
+	result.Timings.Collect = time.Since(start)
+
+	progress := newProgress(cfg.progress)
+	progress.report(ProgressFilesDiscovered, nil, func(totals *DeployProgress) {
+		totals.TotalFiles = len(files)
+		for _, file := range files {
+			totals.TotalBytes += file.size
+		}
+	})
+
+	step := time.Now()
+	if err := hashFiles(ctx, c.settings().uploadConcurrency, files, progress); err != nil {
+		return nil, fmt.Errorf("failed hashing files: %w", err)
+	}
+	result.Timings.Hash = time.Since(step)
+
+	deploymentReq.Files = make([]schemas.DeploymentFile, len(files))
+	for i, file := range files {
+		deploymentReq.Files[i] = file.DeploymentFile
+	}
+
+	// Vercel only needs the files it does not already have: creating the deployment
+	// reports the missing SHAs, which are uploaded before creating it again.
+	step = time.Now()
+	resp, err := c.createDeployment(ctx, "Deploy", teamId, deploymentReq)
+	result.Timings.Create = time.Since(step)
+	if missing, ok := missingFiles(err); ok {
+		step = time.Now()
+		if err := c.uploadFiles(ctx, teamId, files, missing, progress); err != nil {
+			return nil, fmt.Errorf("failed uploading files: %w", err)
+		}
+		result.Timings.Upload = time.Since(step)
+
+		step = time.Now()
+		resp, err = c.createDeployment(ctx, "Deploy", teamId, deploymentReq)
+		result.Timings.Create += time.Since(step)
+	} else if err == nil {
+		for i := range files {
+			progress.report(ProgressUploadSkipped, &files[i], func(totals *DeployProgress) {
+				totals.SkippedFiles++
+			})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	progress.report(ProgressDeploymentCreated, nil, func(totals *DeployProgress) {
+		totals.DeploymentID = resp.Id
+	})
+
+	totals := progress.snapshot()
+	result.Deployment = *resp
+	result.Files, result.Bytes = totals.TotalFiles, totals.TotalBytes
+	result.UploadedFiles, result.UploadedBytes = totals.UploadedFiles, totals.UploadedBytes
+	result.SkippedFiles = totals.SkippedFiles
+
+	if cfg.domainLookup {
+		progress.report(ProgressDomainLookup, nil, nil)
+		step = time.Now()
+		// Vercel deploys to the project named after the deployment when none is given.
+		project := cmp.Or(deploymentReq.Project, deploymentReq.Name)
+		allDomains, err := c.GetProjectDomainsCtx(ctx, project, teamId, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project domains: %w", err)
+		}
+		result.Domains = allDomains
+		result.Timings.DomainLookup = time.Since(step)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package vercelgo
+
+// DefaultIgnorePatterns are the .gitignore-style patterns excluded from every deployment
+// unless replaced with WithDefaultIgnorePatterns: dotfiles, dot-directories and node_modules.
+var DefaultIgnorePatterns = []string{".*", "node_modules/"}
+
+// DeployOption configures a single deployment.
+type DeployOption func(*deployConfig)
+
+type deployConfig struct {
+	defaultIgnores []string
+	ignoreFiles    []string
+	exclude        []string
+	include        []string
+	progress       func(DeployProgress)
+	domainLookup   bool
+}
+
+func newDeployConfig(opts []DeployOption) *deployConfig {
+	cfg := &deployConfig{
+		defaultIgnores: DefaultIgnorePatterns,
+		ignoreFiles:    []string{".vercelignore"},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithDomainLookup also looks up the domains of the project once the deployment is created,
+// to fill DeployResult.Domains.
+func WithDomainLookup() DeployOption {
+	return func(cfg *deployConfig) {
+		cfg.domainLookup = true
+	}
+}
+
+// WithDefaultIgnorePatterns replaces DefaultIgnorePatterns for the deployment.
+// Call it without patterns to deploy every file that is not otherwise ignored.
+func WithDefaultIgnorePatterns(patterns ...string) DeployOption {
+	return func(cfg *deployConfig) {
+		cfg.defaultIgnores = patterns
+	}
+}
+
+// WithGitignore also applies the .gitignore file at the root of the deployed directory.
+// The root .vercelignore file is always applied when present.
+func WithGitignore() DeployOption {
+	return func(cfg *deployConfig) {
+		cfg.ignoreFiles = append(cfg.ignoreFiles, ".gitignore")
+	}
+}
+
+// WithExclude ignores the files matching the given .gitignore-style patterns,
+// on top of the default patterns and ignore files.
+func WithExclude(patterns ...string) DeployOption {
+	return func(cfg *deployConfig) {
+		cfg.exclude = append(cfg.exclude, patterns...)
+	}
+}
+
+// WithInclude deploys the files matching the given .gitignore-style patterns even when
+// an ignore rule excludes them, e.g. WithInclude(".well-known"). As with .gitignore negation,
+// a file cannot be included when one of its parent directories is ignored.
+func WithInclude(patterns ...string) DeployOption {
+	return func(cfg *deployConfig) {
+		for _, pattern := range patterns {
+			cfg.include = append(cfg.include, "!"+pattern)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package vercelgo_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+func TestDeployFSIgnoreRules(t *testing.T) {
+	fsys := fstest.MapFS{
+		".vercelignore":            {Data: []byte("# not deployed\ndrafts/\n*.bak\n")},
+		".gitignore":               {Data: []byte("dist/\n")},
+		".env":                     {Data: []byte("TOKEN=secret")},
+		".well-known/security.txt": {Data: []byte("Contact: security@example.com")},
+		"index.html":               {Data: []byte("<h1>Hello</h1>")},
+		"index.html.bak":           {Data: []byte("<h1>Old</h1>")},
+		"drafts/post.html":         {Data: []byte("<p>Draft</p>")},
+		"dist/app.js":              {Data: []byte("built")},
+		"src/app.js":               {Data: []byte("source")},
+		"node_modules/lib/lib.js":  {Data: []byte("module.exports = {}")},
+	}
+
+	tests := []struct {
+		name string
+		opts []vercelgo.DeployOption
+		want []string
+	}{
+		{
+			name: "vercelignore and defaults",
+			want: []string{"dist/app.js", "index.html", "src/app.js"},
+		},
+		{
+			name: "gitignore",
+			opts: []vercelgo.DeployOption{vercelgo.WithGitignore()},
+			want: []string{"index.html", "src/app.js"},
+		},
+		{
+			name: "include dot directory",
+			opts: []vercelgo.DeployOption{vercelgo.WithGitignore(), vercelgo.WithInclude(".well-known")},
+			want: []string{".well-known/security.txt", "index.html", "src/app.js"},
+		},
+		{
+			name: "exclude",
+			opts: []vercelgo.DeployOption{vercelgo.WithExclude("src/")},
+			want: []string{"dist/app.js", "index.html"},
+		},
+		{
+			name: "include overrides exclude",
+			opts: []vercelgo.DeployOption{vercelgo.WithExclude("*.html"), vercelgo.WithInclude("index.html")},
+			want: []string{"dist/app.js", "index.html", "src/app.js"},
+		},
+		{
+			name: "no default patterns",
+			opts: []vercelgo.DeployOption{vercelgo.WithDefaultIgnorePatterns()},
+			want: []string{
+				".env", ".gitignore", ".vercelignore", ".well-known/security.txt",
+				"dist/app.js", "index.html", "node_modules/lib/lib.js", "src/app.js",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, team, project := newProject(t)
+			result, err := s.Client().DeployFS(context.Background(), project.ID, "site", fsys, team.ID, "", tt.opts...)
+			if err != nil {
+				t.Fatalf("DeployFS: %v", err)
+			}
+
+			var got []string
+			for _, file := range result.Deployment.Files {
+				got = append(got, file.File)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("deployed files %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeployGitSource(t *testing.T) {
+	s, team, project := newProject(t)
+	var operations []string
+	c := s.Client(vercelgo.WithBeforeRequest(func(_ context.Context, _ *http.Request, info vercelgo.RequestInfo) {
+		operations = append(operations, info.Operation)
+	}))
+
+	source := schemas.GitSource{Type: schemas.GitSourceGitHub, RepoId: "123456", Ref: "main", Sha: "a1b2c3"}
+	deployment, err := c.DeployGitSource(context.Background(), project.ID, "site", source, team.ID, "production")
+	if err != nil {
+		t.Fatalf("DeployGitSource: %v", err)
+	}
+	if deployment.GitSource == nil || *deployment.GitSource != source {
+		t.Errorf("deployment git source = %+v, want %+v", deployment.GitSource, source)
+	}
+	if !slices.Equal(operations, []string{"DeployGitSource"}) {
+		t.Errorf("hooks saw operations %v, want [DeployGitSource]", operations)
+	}
+
+	requests := s.Requests()
+	if len(requests) != 1 || requests[0].Method != "POST" || requests[0].Path != "/v13/deployments" {
+		t.Fatalf("got requests %+v, want a single deployment creation", requests)
+	}
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(requests[0].Body, &body); err != nil {
+		t.Fatalf("decoding request body: %v", err)
+	}
+	if _, ok := body["files"]; ok {
+		t.Errorf("request body %s sends files, want them omitted", requests[0].Body)
+	}
+	var sent schemas.GitSource
+	if err := json.Unmarshal(body["gitSource"], &sent); err != nil || sent != source {
+		t.Errorf("request body gitSource = %s, want %+v", body["gitSource"], source)
+	}
+	for key, want := range map[string]string{"name": `"site"`, "project": `"` + project.ID + `"`, "target": `"production"`} {
+		if got := string(body[key]); got != want {
+			t.Errorf("request body %s = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestDeployGitSourceOmitsEmptyFields(t *testing.T) {
+	s, team, _ := newProject(t)
+	source := schemas.GitSource{Type: schemas.GitSourceGitHub, RepoId: "123456", Ref: "main"}
+	// Without a project ID, Vercel resolves the project from the deployment name; without a target it deploys a preview.
+	if _, err := s.Client().DeployGitSource(context.Background(), "", "site", source, team.ID, ""); err != nil {
+		t.Fatalf("DeployGitSource: %v", err)
+	}
+
+	requests := s.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got requests %+v, want a single deployment creation", requests)
+	}
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(requests[0].Body, &body); err != nil {
+		t.Fatalf("decoding request body: %v", err)
+	}
+	for _, key := range []string{"project", "target"} {
+		if value, ok := body[key]; ok {
+			t.Errorf("request body %s = %s, want it omitted", key, value)
+		}
+	}
+}
+
+func TestDeployGitSourceInvalid(t *testing.T) {
+	s, team, project := newProject(t)
+	source := schemas.GitSource{Type: schemas.GitSourceGitLab, ProjectId: "42"}
+	_, err := s.Client().DeployGitSource(context.Background(), project.ID, "site", source, team.ID, "")
+	if err == nil {
+		t.Fatal("DeployGitSource succeeded without a ref, want an error")
+	}
+}
+
+func TestDeployProgressTotalBytes(t *testing.T) {
+	open := func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("streamed")), nil }
+	tests := []struct {
+		name string
+		// deploy deploys the files reporting progress with opt.
+		deploy         func(c *vercelgo.VercelClient, project, team string, opt vercelgo.DeployOption) (*vercelgo.DeployResult, error)
+		discoveredSize int64
+		totalSize      int64
+	}{
+		{
+			name: "DeployFS",
+			deploy: func(c *vercelgo.VercelClient, project, team string, opt vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+				fsys := fstest.MapFS{"index.html": {Data: []byte("<h1>Hello</h1>")}, "app.js": {Data: []byte("app")}}
+				return c.DeployFS(context.Background(), project, "site", fsys, team, "", opt)
+			},
+			discoveredSize: 17,
+			totalSize:      17,
+		},
+		{
+			// The size of a file read with Open is only known once it is hashed.
+			name: "DeployFiles",
+			deploy: func(c *vercelgo.VercelClient, project, team string, opt vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+				files := []vercelgo.DeployFile{{Name: "index.html", Data: []byte("<h1>Hello</h1>")}, {Name: "data.txt", Open: open}}
+				return c.DeployFiles(context.Background(), project, "site", files, team, "", opt)
+			},
+			discoveredSize: 14,
+			totalSize:      22,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, team, project := newProject(t)
+			var events []vercelgo.DeployProgress
+			result, err := tt.deploy(s.Client(), project.ID, team.ID, vercelgo.WithProgress(func(p vercelgo.DeployProgress) {
+				events = append(events, p)
+			}))
+			if err != nil {
+				t.Fatalf("deploy: %v", err)
+			}
+
+			if len(events) == 0 || events[0].Kind != vercelgo.ProgressFilesDiscovered {
+				t.Fatalf("progress events %+v, want files_discovered first", events)
+			}
+			if got := events[0].TotalBytes; got != tt.discoveredSize {
+				t.Errorf("files_discovered TotalBytes = %d, want %d", got, tt.discoveredSize)
+			}
+			for _, event := range events {
+				if event.Kind == vercelgo.ProgressFileHashed && event.HashedFiles == event.TotalFiles && event.TotalBytes != tt.totalSize {
+					t.Errorf("TotalBytes = %d once every file is hashed, want %d", event.TotalBytes, tt.totalSize)
+				}
+			}
+			if result.Bytes != tt.totalSize {
+				t.Errorf("deployed %d bytes, want %d", result.Bytes, tt.totalSize)
+			}
+		})
+	}
+}
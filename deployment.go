@@ -1,143 +1,117 @@
 package vercelgo
 
 import (
-	"bytes"
-	"crypto/sha1"
-	"encoding/hex"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
-	"os"
-	"path/filepath"
-	"strings"
+	"net/url"
+	"strconv"
 	"time"
 
-	"github.com/GitDocAI/vercelgo/config"
 	"github.com/GitDocAI/vercelgo/schemas"
-	"github.com/GitDocAI/vercelgo/utils"
 )
 
-// Deploy uploads files to Vercel from a directory and creates a deployment for the specified project.
-func (c *VercelClient) Deploy(projectId, deploymentName, directory, teamId, target string) (*schemas.AllDomainWithVerification, string, error) {
-	files := []schemas.DeploymentFile{}
-	err := filepath.WalkDir(directory, func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return fmt.Errorf("error accessing path %q: %v", path, err)
-		}
-		name := d.Name()
-		if d.IsDir() {
-			if name == "node_modules" || name == ".git" || name == ".next" || strings.HasPrefix(name, ".") {
-				return filepath.SkipDir
-			}
-			return nil
-		} else {
-			if strings.HasPrefix(name, ".") {
-				return nil
-			}
-		}
-
-		relPath, err := filepath.Rel(directory, path)
-		if err != nil {
-			return fmt.Errorf("error getting relative path for %q: %v", path, err)
-		}
-
-		content, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("error reading file %q: %v", path, err)
-		}
-
-		hashBytes := sha1.Sum(content)
-		hash := hex.EncodeToString(hashBytes[:])
-
-		req, err := http.NewRequest("POST", fmt.Sprintf("%s/v2/files?teamId=%s", config.BaseURL, teamId), bytes.NewReader(content))
-		if err != nil {
-			return fmt.Errorf("error creating request for %q: %v", path, err)
-		}
-		req.Header.Set("Authorization", "Bearer "+c.Token)
-		req.Header.Set("x-vercel-digest", hash)
-		req.Header.Set("Content-Length", fmt.Sprintf("%d", len(content)))
-		req.Header.Set("Content-Type", "application/octet-stream")
-
-		client := &http.Client{Timeout: 15 * time.Second}
-		res, err := client.Do(req)
-		if err != nil {
-			return fmt.Errorf("error uploading file %q: %v", path, err)
-		}
-		defer res.Body.Close()
-
-		if res.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(res.Body)
-			return fmt.Errorf("upload failed (%d): %s", res.StatusCode, string(body))
-		}
-
-		files = append(files, schemas.DeploymentFile{
-			File: relPath,
-			Sha:  hash,
-		})
-		return nil
-	})
-
-	if err != nil {
-		return nil, "", fmt.Errorf("failed walking files: %w", err)
-	}
-
-	deploymentReq := schemas.CreateDeploymentRequest{
-		Name:    deploymentName,
-		Project: projectId,
-		Files:   files,
-		Target:  target,
-	}
-
+// createDeployment creates the deployment described by deploymentReq, whose files must already be uploaded.
+// op names the client method creating the deployment and is reported to the client hooks.
+func (c *VercelClient) createDeployment(ctx context.Context, op, teamId string, deploymentReq schemas.CreateDeploymentRequest) (*schemas.DeploymentResponse, error) {
 	body, err := json.Marshal(deploymentReq)
 	if err != nil {
-		return nil, "", fmt.Errorf("marshal deployment error: %w", err)
+		return nil, fmt.Errorf("marshal deployment error: %w", err)
 	}
 
-	resp, status, err := utils.DoReq[schemas.DeploymentResponse](fmt.Sprintf("%s/v13/deployments?teamId=%s", config.BaseURL, teamId), body, "POST", c.GetHeaders(), false, 30*time.Second)
+	resp, status, err := doRequest[schemas.DeploymentResponse](ctx, c, op, "POST", c.url(teamQuery(teamId), "/v13/deployments"), body)
 	if err != nil {
-		return nil, "", fmt.Errorf("create deployment error: %w", err)
+		return nil, fmt.Errorf("create deployment error: %w", err)
 	}
 	if status != http.StatusOK && status != http.StatusCreated {
-		return nil, "", fmt.Errorf("deployment failed with status %d", status)
+		return nil, fmt.Errorf("deployment failed with status %d", status)
 	}
+	return &resp, nil
+}
 
-	allDomains, err := c.GetProjectDomains(projectId, teamId, nil)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get project domains: %w", err)
+// missingFiles reports whether err is a missing_files error of deployment creation,
+// returning the SHAs Vercel listed as missing, or nil when it did not list them.
+func missingFiles(err error) ([]string, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "missing_files" {
+		return nil, false
 	}
 
-	return allDomains, resp.Id, nil
+	var payload struct {
+		Error struct {
+			Missing []string `json:"missing"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(apiErr.Body, &payload) != nil || len(payload.Error.Missing) == 0 {
+		return nil, true
+	}
+	return payload.Error.Missing, true
 }
 
 // GetDeployments retrieves the list of deployments for a specific project and team.
 func (c *VercelClient) GetDeployments(projectId, teamId string) ([]schemas.DeploymentResponse, error) {
-	response, status, err := utils.DoReq[schemas.DeploymentListResponse](
-		fmt.Sprintf("%s/v6/deployments?projectId=%s&teamId=%s", config.BaseURL, projectId, teamId),
-		nil,
+	return c.GetDeploymentsCtx(context.Background(), projectId, teamId)
+}
+
+// GetDeploymentsCtx is like GetDeployments but uses ctx for every request it makes.
+func (c *VercelClient) GetDeploymentsCtx(ctx context.Context, projectId, teamId string) ([]schemas.DeploymentResponse, error) {
+	deployments, _, err := c.listDeploymentsPage(ctx, projectId, teamId, 0)
+	return deployments, err
+}
+
+// IterDeployments returns an iterator over all the deployments of a project, fetching further pages as needed.
+func (c *VercelClient) IterDeployments(ctx context.Context, projectId, teamId string) iter.Seq2[schemas.DeploymentResponse, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor int64) ([]schemas.DeploymentResponse, schemas.Pagination, error) {
+		return c.listDeploymentsPage(ctx, projectId, teamId, cursor)
+	})
+}
+
+// ListDeploymentsAll returns all the deployments of a project, across every page.
+func (c *VercelClient) ListDeploymentsAll(ctx context.Context, projectId, teamId string) ([]schemas.DeploymentResponse, error) {
+	return Collect(c.IterDeployments(ctx, projectId, teamId))
+}
+
+// listDeploymentsPage fetches the page of deployments created before until, or the first page when until is zero.
+func (c *VercelClient) listDeploymentsPage(ctx context.Context, projectId, teamId string, until int64) ([]schemas.DeploymentResponse, schemas.Pagination, error) {
+	query := url.Values{"projectId": {projectId}, "teamId": {c.team(teamId)}}
+	if until != 0 {
+		query.Set("until", strconv.FormatInt(until, 10))
+	}
+
+	response, status, err := doRequest[schemas.DeploymentListResponse](
+		ctx,
+		c,
+		"GetDeployments",
 		"GET",
-		c.GetHeaders(),
-		false,
-		30*time.Second,
+		c.url(query, "/v6/deployments"),
+		nil,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("get deployments error: %w", err)
+		return nil, schemas.Pagination{}, fmt.Errorf("get deployments error: %w", err)
 	}
 	if status != http.StatusOK {
-		return nil, fmt.Errorf("failed to get deployments with code %d", status)
+		return nil, schemas.Pagination{}, fmt.Errorf("failed to get deployments with code %d", status)
 	}
-	return response.Deployments, nil
+	return response.Deployments, response.Pagination, nil
 }
 
 // GetDeploymentStatus gets the status of a specific deployment by its ID and team ID.
 func (c *VercelClient) GetDeploymentStatus(deploymentId, teamId string) (*schemas.DeploymentStatus, error) {
-	deploymentStatus, status, err := utils.DoReq[schemas.DeploymentStatus](
-		fmt.Sprintf("%s/v13/deployments/%s?teamId=%s", config.BaseURL, deploymentId, teamId),
-		nil,
+	return c.GetDeploymentStatusCtx(context.Background(), deploymentId, teamId)
+}
+
+// GetDeploymentStatusCtx is like GetDeploymentStatus but uses ctx for every request it makes.
+func (c *VercelClient) GetDeploymentStatusCtx(ctx context.Context, deploymentId, teamId string) (*schemas.DeploymentStatus, error) {
+	deploymentStatus, status, err := doRequest[schemas.DeploymentStatus](
+		ctx,
+		c,
+		"GetDeploymentStatus",
 		"GET",
-		c.GetHeaders(),
-		false,
-		30*time.Second,
+		c.url(teamQuery(c.team(teamId)), "/v13/deployments/%s", deploymentId),
+		nil,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("get deployment status error: %w", err)
@@ -149,47 +123,35 @@ func (c *VercelClient) GetDeploymentStatus(deploymentId, teamId string) (*schema
 	return &deploymentStatus, nil
 }
 
-// WaitForDeployment waits for a specific deployment to finish.
+// WaitForDeployment waits for a specific deployment to finish, polling its status every 5 seconds
+// for up to timeout, or 10 minutes when timeout is zero. WaitDeployment offers more control.
 func (c *VercelClient) WaitForDeployment(deploymentId, teamId string, timeout time.Duration) (*schemas.DeploymentStatus, error) {
+	return c.WaitForDeploymentCtx(context.Background(), deploymentId, teamId, timeout)
+}
+
+// WaitForDeploymentCtx is like WaitForDeployment but uses ctx for every request it makes
+// and stops waiting as soon as ctx is done.
+func (c *VercelClient) WaitForDeploymentCtx(ctx context.Context, deploymentId, teamId string, timeout time.Duration) (*schemas.DeploymentStatus, error) {
 	if timeout == 0 {
 		timeout = 10 * time.Minute
 	}
-
-	startTime := time.Now()
-	checkInterval := 5 * time.Second
-
-	for {
-		if time.Since(startTime) > timeout {
-			return nil, fmt.Errorf("deployment monitoring timed out after %v", timeout)
-		}
-
-		status, err := c.GetDeploymentStatus(deploymentId, teamId)
-		if err != nil {
-			return nil, fmt.Errorf("error checking deployment status: %w", err)
-		}
-
-		switch status.ReadyState {
-		case "READY":
-			return status, nil
-		case "ERROR":
-			return status, fmt.Errorf("deployment failed with state: %s", status.ReadyState)
-		case "CANCELED":
-			return status, fmt.Errorf("deployment was canceled")
-		}
-
-		time.Sleep(checkInterval)
-	}
+	return c.WaitDeployment(ctx, deploymentId, teamId, WithWaitTimeout(timeout), WithPollInterval(5*time.Second, 5*time.Second))
 }
 
 // DeleteDeployment removes a specific deployment by its ID and team ID.
 func (c *VercelClient) DeleteDeployment(deploymentId, teamId string) error {
-	_, status, err := utils.DoReq[struct{}](
-		fmt.Sprintf("%s/v13/deployments/%s?teamId=%s", config.BaseURL, deploymentId, teamId),
-		nil,
+	return c.DeleteDeploymentCtx(context.Background(), deploymentId, teamId)
+}
+
+// DeleteDeploymentCtx is like DeleteDeployment but uses ctx for every request it makes.
+func (c *VercelClient) DeleteDeploymentCtx(ctx context.Context, deploymentId, teamId string) error {
+	_, status, err := doRequest[struct{}](
+		ctx,
+		c,
+		"DeleteDeployment",
 		"DELETE",
-		c.GetHeaders(),
-		false,
-		30*time.Second,
+		c.url(teamQuery(c.team(teamId)), "/v13/deployments/%s", deploymentId),
+		nil,
 	)
 	if err != nil {
 		return fmt.Errorf("delete deployment error: %w", err)
@@ -202,13 +164,18 @@ func (c *VercelClient) DeleteDeployment(deploymentId, teamId string) error {
 
 // GetCurrentDeployment retrieves the current deployment for a specific project and team.
 func (c *VercelClient) GetCurrentDeployment(projectId, teamId string) (*schemas.CurrentDeployment, error) {
-	response, status, err := utils.DoReq[schemas.CurrentDeploymentResponse](
-		fmt.Sprintf("%s/v1/projects/%s/production-deployment?teamId=%s", config.BaseURL, projectId, teamId),
-		nil,
+	return c.GetCurrentDeploymentCtx(context.Background(), projectId, teamId)
+}
+
+// GetCurrentDeploymentCtx is like GetCurrentDeployment but uses ctx for every request it makes.
+func (c *VercelClient) GetCurrentDeploymentCtx(ctx context.Context, projectId, teamId string) (*schemas.CurrentDeployment, error) {
+	response, status, err := doRequest[schemas.CurrentDeploymentResponse](
+		ctx,
+		c,
+		"GetCurrentDeployment",
 		"GET",
-		c.GetHeaders(),
-		false,
-		30*time.Second,
+		c.url(teamQuery(c.team(teamId)), "/v1/projects/%s/production-deployment", projectId),
+		nil,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("get current deployment error: %w", err)
@@ -222,42 +189,53 @@ func (c *VercelClient) GetCurrentDeployment(projectId, teamId string) (*schemas.
 
 // CleanDeployments deletes all deployments except the one that is in production and is currently active.
 func (c *VercelClient) CleanDeployments(projectId, teamId string) error {
-	currentDeployment, err := c.GetCurrentDeployment(projectId, teamId)
+	return c.CleanDeploymentsCtx(context.Background(), projectId, teamId)
+}
+
+// CleanDeploymentsCtx is like CleanDeployments but uses ctx for every request it makes.
+func (c *VercelClient) CleanDeploymentsCtx(ctx context.Context, projectId, teamId string) error {
+	currentDeployment, err := c.GetCurrentDeploymentCtx(ctx, projectId, teamId)
 	if err != nil {
 		return fmt.Errorf("failed to get current deployment: %w", err)
 	}
 
-	deployments, err := c.GetDeployments(projectId, teamId)
+	deployments, err := c.ListDeploymentsAll(ctx, projectId, teamId)
 	if err != nil {
 		return fmt.Errorf("failed to get deployments: %w", err)
 	}
 
 	for _, d := range deployments {
 		if d.Uid != currentDeployment.Id && currentDeployment.Id != "" {
-			if err := c.DeleteDeployment(d.Uid, teamId); err != nil {
+			if err := c.DeleteDeploymentCtx(ctx, d.Uid, teamId); err != nil {
 				return fmt.Errorf("failed to delete deployment %s: %w", d.Uid, err)
 			}
+			c.settings().logger.DebugContext(ctx, "deleted deployment", "deploymentId", d.Uid, "projectId", projectId)
 		}
 	}
 
 	return nil
 }
 
+// GetDeploymentLogs returns the first 10 build events of the current production deployment of a project.
+// Use IterDeploymentLogs to read the whole log of any deployment.
+func (c *VercelClient) GetDeploymentLogs(projectId, teamId string) ([]schemas.DeployLogsResponse, error) {
+	return c.GetDeploymentLogsCtx(context.Background(), projectId, teamId)
+}
 
-
-func (c *VercelClient) GetDeploymentLogs(projectId, teamId string) ([]schemas.DeployLogsResponse,error) {
-	currentDeployment, err := c.GetCurrentDeployment(projectId, teamId)
+// GetDeploymentLogsCtx is like GetDeploymentLogs but uses ctx for every request it makes.
+func (c *VercelClient) GetDeploymentLogsCtx(ctx context.Context, projectId, teamId string) ([]schemas.DeployLogsResponse, error) {
+	currentDeployment, err := c.GetCurrentDeploymentCtx(ctx, projectId, teamId)
 	if err != nil {
-		return nil,fmt.Errorf("failed to get current deployment: %w", err)
+		return nil, fmt.Errorf("failed to get current deployment: %w", err)
 	}
 
-	response, status, err := utils.DoReq[[]schemas.DeployLogsResponse](
-		fmt.Sprintf("%s/v3/deployments/%s/events?teamId=%s&direction=forward&limit=10", config.BaseURL, currentDeployment.Id, teamId),
-		nil,
+	response, status, err := doRequest[[]schemas.DeployLogsResponse](
+		ctx,
+		c,
+		"GetDeploymentLogs",
 		"GET",
-		c.GetHeaders(),
-		false,
-		30*time.Second,
+		c.url(url.Values{"teamId": {c.team(teamId)}, "direction": {"forward"}, "limit": {"10"}}, "/v3/deployments/%s/events", currentDeployment.Id),
+		nil,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("get current deployment logs error: %w", err)
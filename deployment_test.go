@@ -0,0 +1,221 @@
+package vercelgo_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/vercelgotest"
+)
+
+// writeFiles creates the given files, keyed by slash-separated path, under dir.
+func writeFiles(t testing.TB, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// fastPolling makes WaitDeployment poll without delay.
+var fastPolling = vercelgo.WithPollInterval(time.Millisecond, time.Millisecond)
+
+func TestDeployWaitAndClean(t *testing.T) {
+	ctx := context.Background()
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	project := s.AddProject(team.ID, schemas.Project{Name: "site"})
+	c := s.Client()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"index.html":          "<h1>Hello</h1>",
+		"assets/app.js":       "console.log('hello')",
+		".env":                "TOKEN=secret",
+		"node_modules/lib.js": "module.exports = {}",
+	})
+
+	deploy := func() *vercelgo.DeployResult {
+		t.Helper()
+		result, err := c.DeployDir(ctx, project.ID, "site", dir, team.ID, "production")
+		if err != nil {
+			t.Fatalf("DeployDir: %v", err)
+		}
+		var states []schemas.ReadyState
+		status, err := c.WaitDeployment(ctx, result.Deployment.Id, team.ID, fastPolling,
+			vercelgo.WithStateChange(func(_ schemas.ReadyState, status *schemas.DeploymentStatus) {
+				states = append(states, status.ReadyState)
+			}))
+		if err != nil {
+			t.Fatalf("WaitDeployment: %v", err)
+		}
+		if status.ReadyState != schemas.ReadyStateReady {
+			t.Errorf("ready state = %q, want READY", status.ReadyState)
+		}
+		if want := []schemas.ReadyState{schemas.ReadyStateBuilding, schemas.ReadyStateReady}; !slices.Equal(states, want) {
+			t.Errorf("observed states %v, want %v", states, want)
+		}
+		return result
+	}
+
+	// The first deployment uploads the files reported missing before creating the deployment again.
+	first := deploy()
+	if first.Files != 2 || first.UploadedFiles != 2 || first.SkippedFiles != 0 {
+		t.Errorf("first deployment: %d files, %d uploaded, %d skipped, want 2, 2 and 0",
+			first.Files, first.UploadedFiles, first.SkippedFiles)
+	}
+	var deployed []string
+	for _, file := range first.Deployment.Files {
+		deployed = append(deployed, file.File)
+		content, _ := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file.File)))
+		if sum := sha1.Sum(content); file.Sha != hex.EncodeToString(sum[:]) {
+			t.Errorf("file %q deployed with SHA %s, want the SHA of its content", file.File, file.Sha)
+		}
+		if size, ok := s.File(file.Sha); !ok || size != int64(len(content)) {
+			t.Errorf("uploaded %d bytes for %q, want %d", size, file.File, len(content))
+		}
+	}
+	slices.Sort(deployed)
+	if want := []string{"assets/app.js", "index.html"}; !slices.Equal(deployed, want) {
+		t.Errorf("deployed files %v, want %v", deployed, want)
+	}
+	if n := countRequests(s, "POST", "/v13/deployments"); n != 2 {
+		t.Errorf("got %d deployment creations, want 2", n)
+	}
+
+	// The second deployment finds every file already uploaded.
+	second := deploy()
+	if second.UploadedFiles != 0 || second.SkippedFiles != 2 {
+		t.Errorf("second deployment: %d uploaded, %d skipped, want 0 and 2", second.UploadedFiles, second.SkippedFiles)
+	}
+	if n := countRequests(s, "POST", "/v2/files"); n != 2 {
+		t.Errorf("got %d uploads, want 2", n)
+	}
+
+	if err := c.CleanDeploymentsCtx(ctx, project.ID, team.ID); err != nil {
+		t.Fatalf("CleanDeploymentsCtx: %v", err)
+	}
+	remaining := s.Deployments(project.ID)
+	if len(remaining) != 1 || remaining[0].Id != second.Deployment.Id {
+		t.Errorf("remaining deployments %v, want only %s", remaining, second.Deployment.Id)
+	}
+}
+
+func TestWaitDeploymentError(t *testing.T) {
+	ctx := context.Background()
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	project := s.AddProject(team.ID, schemas.Project{Name: "site"})
+	deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{})
+	s.FailDeployment(deployment.Id, "BUILD_FAILED", "Command failed", "build")
+
+	status, err := s.Client().WaitDeployment(ctx, deployment.Id, team.ID, fastPolling)
+	var deploymentErr *vercelgo.DeploymentError
+	if !errors.As(err, &deploymentErr) {
+		t.Fatalf("WaitDeployment error = %v, want a *DeploymentError", err)
+	}
+	want := vercelgo.DeploymentError{
+		DeploymentID: deployment.Id,
+		ReadyState:   schemas.ReadyStateError,
+		Code:         "BUILD_FAILED",
+		Message:      "Command failed",
+		Step:         "build",
+	}
+	if *deploymentErr != want {
+		t.Errorf("DeploymentError = %+v, want %+v", *deploymentErr, want)
+	}
+	if status == nil || status.ReadyState != schemas.ReadyStateError {
+		t.Errorf("status = %+v, want the ERROR status", status)
+	}
+}
+
+func TestWaitDeploymentTimeout(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	project := s.AddProject(team.ID, schemas.Project{Name: "site"})
+	deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{}, schemas.ReadyStateBuilding)
+
+	_, err := s.Client().WaitDeployment(context.Background(), deployment.Id, team.ID, fastPolling,
+		vercelgo.WithWaitTimeout(50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitDeployment error = %v, want a deadline exceeded error", err)
+	}
+}
+
+func TestWaitDeploymentEventStream(t *testing.T) {
+	tests := []struct {
+		name       string
+		failStream bool
+		wantEvents []string
+	}{
+		{
+			// The stream ends once the deployment is ready, whose status is then polled.
+			name:       "until ready",
+			wantEvents: stateEvents(schemas.ReadyStateQueued, schemas.ReadyStateBuilding, schemas.ReadyStateReady),
+		},
+		{
+			// Polling takes over, going through the remaining states.
+			name:       "stream fails",
+			failStream: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, team, project := newProject(t)
+			deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{})
+			eventsPath := "/v3/deployments/" + deployment.Id + "/events"
+			if tt.failStream {
+				s.Fail(vercelgotest.Failure{Method: "GET", Path: eventsPath, Times: vercelgo.DefaultRetryPolicy().MaxAttempts})
+			}
+
+			var events []schemas.DeployLogsResponse
+			status, err := s.Client().WaitDeployment(context.Background(), deployment.Id, team.ID, fastPolling,
+				vercelgo.WithEventStream(func(event schemas.DeployLogsResponse) { events = append(events, event) }))
+			if err != nil {
+				t.Fatalf("WaitDeployment: %v", err)
+			}
+			if status.ReadyState != schemas.ReadyStateReady {
+				t.Errorf("ready state = %q, want %q", status.ReadyState, schemas.ReadyStateReady)
+			}
+			if got := eventTexts(events); !slices.Equal(got, tt.wantEvents) {
+				t.Errorf("streamed events %v, want %v", got, tt.wantEvents)
+			}
+			// The first poll finds the deployment building and the second one ready.
+			if n := countRequests(s, "GET", "/v13/deployments/"+deployment.Id); n != 2 {
+				t.Errorf("got %d status polls, want 2", n)
+			}
+			if n := countRequests(s, "GET", eventsPath); n == 0 {
+				t.Error("WaitDeployment did not follow the event stream")
+			}
+		})
+	}
+}
+
+func TestWaitDeploymentEventStreamTimeout(t *testing.T) {
+	s, team, project := newProject(t)
+	// The deployment keeps building, so the stream stays open until the wait times out.
+	deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{},
+		schemas.ReadyStateQueued, schemas.ReadyStateBuilding)
+
+	_, err := s.Client().WaitDeployment(context.Background(), deployment.Id, team.ID, fastPolling,
+		vercelgo.WithEventStream(nil), vercelgo.WithWaitTimeout(50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitDeployment error = %v, want a deadline exceeded error", err)
+	}
+}
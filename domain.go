@@ -1,11 +1,12 @@
 package vercelgo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"iter"
+	"strings"
 
-	"github.com/GitDocAI/vercelgo/config"
 	"github.com/GitDocAI/vercelgo/schemas"
 	"github.com/GitDocAI/vercelgo/utils"
 )
@@ -14,6 +15,12 @@ import (
 // It requires the domain name, team ID, project ID or name
 // and returns the domain information along with its configuration.
 func (c *VercelClient) AddProjectDomain(domainName, teamId, projectIdOrName string) (*schemas.AllDomainWithVerification, error) {
+	return c.AddProjectDomainCtx(context.Background(), domainName, teamId, projectIdOrName)
+}
+
+// AddProjectDomainCtx is like AddProjectDomain but uses ctx for every request it makes.
+func (c *VercelClient) AddProjectDomainCtx(ctx context.Context, domainName, teamId, projectIdOrName string) (*schemas.AllDomainWithVerification, error) {
+	teamId = c.team(teamId)
 	reqBody := schemas.Domain{
 		Name: domainName,
 	}
@@ -23,8 +30,8 @@ func (c *VercelClient) AddProjectDomain(domainName, teamId, projectIdOrName stri
 		return nil, fmt.Errorf("failed to marshal domain request: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/v10/projects/%s/domains?teamId=%s", config.BaseURL, projectIdOrName, teamId)
-	_, status, err := utils.DoReq[schemas.DomainInfo](url, bodyBytes, "POST", c.GetHeaders(), false, 15*time.Second)
+	url := c.url(teamQuery(teamId), "/v10/projects/%s/domains", projectIdOrName)
+	_, status, err := doRequest[schemas.DomainInfo](ctx, c, "AddProjectDomain", "POST", url, bodyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("error adding domain: %w", err)
 	}
@@ -32,19 +39,25 @@ func (c *VercelClient) AddProjectDomain(domainName, teamId, projectIdOrName stri
 		return nil, fmt.Errorf("unexpected status code: %d", status)
 	}
 
-	return c.GetProjectDomains(projectIdOrName, teamId, nil)
+	return c.GetProjectDomainsCtx(ctx, projectIdOrName, teamId, nil)
 }
 
 // DeleteProjectDomain removes a domain from a specific project by its name or ID.
 // It requires the domain name, project ID or name, and team ID.
 func (c *VercelClient) DeleteProjectDomain(domainName, projectIdOrName, teamId string) (*schemas.AllDomainWithVerification, error) {
+	return c.DeleteProjectDomainCtx(context.Background(), domainName, projectIdOrName, teamId)
+}
+
+// DeleteProjectDomainCtx is like DeleteProjectDomain but uses ctx for every request it makes.
+func (c *VercelClient) DeleteProjectDomainCtx(ctx context.Context, domainName, projectIdOrName, teamId string) (*schemas.AllDomainWithVerification, error) {
+	teamId = c.team(teamId)
 	if domainName == "" || projectIdOrName == "" || teamId == "" {
 		return nil, fmt.Errorf("domainName, projectIdOrName, and teamId are required")
 	}
 
-	url := fmt.Sprintf("%s/v9/projects/%s/domains/%s?teamId=%s", config.BaseURL, projectIdOrName, domainName, teamId)
+	url := c.url(teamQuery(teamId), "/v9/projects/%s/domains/%s", projectIdOrName, domainName)
 
-	_, status, err := utils.DoReq[interface{}](url, nil, "DELETE", c.GetHeaders(), false, 15*time.Second)
+	_, status, err := doRequest[interface{}](ctx, c, "DeleteProjectDomain", "DELETE", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error deleting domain: %w", err)
 	}
@@ -52,33 +65,26 @@ func (c *VercelClient) DeleteProjectDomain(domainName, projectIdOrName, teamId s
 		return nil, fmt.Errorf("unexpected status code: %d", status)
 	}
 
-	return c.GetProjectDomains(projectIdOrName, teamId, nil)
+	return c.GetProjectDomainsCtx(ctx, projectIdOrName, teamId, nil)
 }
 
 // GetProjectDomains retrieves all domains associated with a project by ID or name.
 // It supports various filtering options including production, target environment, git branch, etc.
 func (c *VercelClient) GetProjectDomains(projectIdOrName, teamId string, opts *schemas.Options) (*schemas.AllDomainWithVerification, error) {
-	if projectIdOrName == "" || teamId == "" {
-		return nil, fmt.Errorf("projectIdOrName and teamId are required")
-	}
-
-	url := fmt.Sprintf("%s/v9/projects/%s/domains", config.BaseURL, projectIdOrName)
-	params := utils.BuildProjectDomainsParams(teamId, opts)
-	if params != "" {
-		url += "?" + params
-	}
+	return c.GetProjectDomainsCtx(context.Background(), projectIdOrName, teamId, opts)
+}
 
-	response, status, err := utils.DoReq[schemas.ProjectDomainsResponse](url, nil, "GET", c.GetHeaders(), false, 15*time.Second)
+// GetProjectDomainsCtx is like GetProjectDomains but uses ctx for every request it makes.
+func (c *VercelClient) GetProjectDomainsCtx(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) (*schemas.AllDomainWithVerification, error) {
+	teamId = c.team(teamId)
+	domains, _, err := c.listProjectDomainsPage(ctx, projectIdOrName, teamId, opts, 0)
 	if err != nil {
-		return nil, fmt.Errorf("error getting project domains: %w", err)
-	}
-	if status != 200 {
-		return nil, fmt.Errorf("unexpected status code: %d", status)
+		return nil, err
 	}
 
-	domainsWithVerification := make([]schemas.DomainInfoWithVerification, len(response.Domains))
-	for i, domain := range response.Domains {
-		config, err := c.GetDomainConfig(domain.Name, teamId)
+	domainsWithVerification := make([]schemas.DomainInfoWithVerification, len(domains))
+	for i, domain := range domains {
+		config, err := c.GetDomainConfigCtx(ctx, domain.Name, teamId)
 		if err != nil {
 			return nil, fmt.Errorf("error getting config for domain %s: %w", domain.Name, err)
 		}
@@ -92,12 +98,64 @@ func (c *VercelClient) GetProjectDomains(projectIdOrName, teamId string, opts *s
 	}, nil
 }
 
+// IterProjectDomains returns an iterator over all the domains of a project, fetching further pages as needed.
+// Unlike GetProjectDomains it does not fetch the configuration of each domain.
+// The page size and filters are taken from opts; opts.Since and opts.Until bound every page.
+func (c *VercelClient) IterProjectDomains(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) iter.Seq2[schemas.DomainInfo, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor int64) ([]schemas.DomainInfo, schemas.Pagination, error) {
+		return c.listProjectDomainsPage(ctx, projectIdOrName, teamId, opts, cursor)
+	})
+}
+
+// ListProjectDomainsAll returns all the domains of a project, across every page.
+func (c *VercelClient) ListProjectDomainsAll(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options) ([]schemas.DomainInfo, error) {
+	return Collect(c.IterProjectDomains(ctx, projectIdOrName, teamId, opts))
+}
+
+// listProjectDomainsPage fetches the page of project domains following cursor, or the first page when cursor is zero.
+// The cursor replaces the bound it moves: opts.Since for ascending order and opts.Until otherwise.
+func (c *VercelClient) listProjectDomainsPage(ctx context.Context, projectIdOrName, teamId string, opts *schemas.Options, cursor int64) ([]schemas.DomainInfo, schemas.Pagination, error) {
+	teamId = c.team(teamId)
+	if projectIdOrName == "" || teamId == "" {
+		return nil, schemas.Pagination{}, fmt.Errorf("projectIdOrName and teamId are required")
+	}
+
+	var pageOpts schemas.Options
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if cursor != 0 {
+		if pageOpts.Order != nil && strings.EqualFold(*pageOpts.Order, "ASC") {
+			pageOpts.Since = &cursor
+		} else {
+			pageOpts.Until = &cursor
+		}
+	}
+
+	url := c.url(utils.ProjectDomainsValues(teamId, &pageOpts), "/v9/projects/%s/domains", projectIdOrName)
+
+	response, status, err := doRequest[schemas.ProjectDomainsResponse](ctx, c, "GetProjectDomains", "GET", url, nil)
+	if err != nil {
+		return nil, schemas.Pagination{}, fmt.Errorf("error getting project domains: %w", err)
+	}
+	if status != 200 {
+		return nil, schemas.Pagination{}, fmt.Errorf("unexpected status code: %d", status)
+	}
+
+	return response.Domains, response.Pagination, nil
+}
+
 // GetDomainConfig retrieves the configuration details of a domain by its name and team ID.
 // It returns a DomainConfigInfo struct containing the configuration details.
 func (c *VercelClient) GetDomainConfig(domainName, teamId string) (*schemas.DomainConfigInfo, error) {
-	url := fmt.Sprintf("%s/v6/domains/%s/config?teamId=%s", config.BaseURL, domainName, teamId)
+	return c.GetDomainConfigCtx(context.Background(), domainName, teamId)
+}
 
-	response, status, err := utils.DoReq[schemas.DomainConfigInfo](url, nil, "GET", c.GetHeaders(), false, 15*time.Second)
+// GetDomainConfigCtx is like GetDomainConfig but uses ctx for every request it makes.
+func (c *VercelClient) GetDomainConfigCtx(ctx context.Context, domainName, teamId string) (*schemas.DomainConfigInfo, error) {
+	url := c.url(teamQuery(c.team(teamId)), "/v6/domains/%s/config", domainName)
+
+	response, status, err := doRequest[schemas.DomainConfigInfo](ctx, c, "GetDomainConfig", "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error getting domain config: %w", err)
 	}
@@ -108,11 +166,15 @@ func (c *VercelClient) GetDomainConfig(domainName, teamId string) (*schemas.Doma
 	return &response, nil
 }
 
+func (c *VercelClient) ForceDNSVerification(domainName, projectId, teamId string) (*schemas.ProjectDomanVerification, error) {
+	return c.ForceDNSVerificationCtx(context.Background(), domainName, projectId, teamId)
+}
 
-func (c *VercelClient) ForceDNSVerification(domainName,projectId, teamId string) (*schemas.ProjectDomanVerification, error) {
-	url := fmt.Sprintf("%s/v9/projects/%s/domains/%s/verify?teamId=%s", config.BaseURL,projectId, domainName,teamId)
+// ForceDNSVerificationCtx is like ForceDNSVerification but uses ctx for every request it makes.
+func (c *VercelClient) ForceDNSVerificationCtx(ctx context.Context, domainName, projectId, teamId string) (*schemas.ProjectDomanVerification, error) {
+	url := c.url(teamQuery(c.team(teamId)), "/v9/projects/%s/domains/%s/verify", projectId, domainName)
 
-	response, status, err := utils.DoReq[schemas.ProjectDomanVerification](url, nil, "POST", c.GetHeaders(), false, 15*time.Second)
+	response, status, err := doRequest[schemas.ProjectDomanVerification](ctx, c, "ForceDNSVerification", "POST", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error verifyng domain: %w", err)
 	}
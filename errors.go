@@ -0,0 +1,152 @@
+package vercelgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/utils"
+)
+
+// RateLimit holds the rate-limit headers Vercel returns with a response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// APIError is returned when the Vercel API answers with a non-2xx status code.
+// Use errors.As to retrieve it from errors returned by the client.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Code and Message are taken from the "error" object of the response body.
+	Code    string
+	Message string
+	// RequestID is the value of the x-vercel-id response header.
+	RequestID string
+	RateLimit RateLimit
+	// RetryAfter is the delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("vercel: %s %s: status %d", e.Method, e.URL, e.StatusCode)
+	if e.Code != "" {
+		msg += " " + e.Code
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestID != "" {
+		msg += " (request id " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsForbidden reports whether err is an APIError with status 403.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an APIError with status 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an APIError caused by Vercel rate limiting.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.Code == "rate_limited"
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// DeploymentError is returned when a waited deployment ends in the ERROR or CANCELED state.
+// Use errors.As to retrieve it from errors returned by the client.
+type DeploymentError struct {
+	DeploymentID string
+	ReadyState   schemas.ReadyState
+	// Code, Message and Step describe why the build failed, when Vercel reports it.
+	Code    string
+	Message string
+	Step    string
+}
+
+func (e *DeploymentError) Error() string {
+	msg := fmt.Sprintf("vercel: deployment %s ended in state %s", e.DeploymentID, e.ReadyState)
+	if e.Step != "" {
+		msg += " at step " + e.Step
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a raw HTTP error response.
+func newAPIError(method, url string, status int, header http.Header, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		URL:        url,
+		StatusCode: status,
+		RequestID:  header.Get("x-vercel-id"),
+		Body:       body,
+	}
+
+	var payload struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Code = payload.Error.Code
+		apiErr.Message = payload.Error.Message
+	}
+
+	apiErr.RateLimit.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	apiErr.RateLimit.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		apiErr.RateLimit.Reset = time.Unix(reset, 0)
+	}
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			apiErr.RetryAfter = time.Duration(seconds) * time.Second
+		} else if at, err := http.ParseTime(retryAfter); err == nil {
+			apiErr.RetryAfter = time.Until(at)
+		}
+	}
+
+	return apiErr
+}
+
+// asAPIError converts a utils.StatusError into an APIError, leaving other errors untouched.
+func asAPIError(err error) error {
+	var statusErr *utils.StatusError
+	if errors.As(err, &statusErr) {
+		return newAPIError(statusErr.Method, statusErr.URL, statusErr.StatusCode, statusErr.Header, statusErr.Body)
+	}
+	return err
+}
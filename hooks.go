@@ -0,0 +1,77 @@
+package vercelgo
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestInfo describes a Vercel API request.
+type RequestInfo struct {
+	// Operation is the client method that issued the request, e.g. "CreateProject".
+	Operation string
+	Method    string
+	Path      string
+}
+
+// ResponseInfo describes the outcome of a Vercel API request.
+// StatusCode is zero when no response was received.
+type ResponseInfo struct {
+	RequestInfo
+	StatusCode int
+	Latency    time.Duration
+	Err        error
+}
+
+// BeforeRequestHook is called before every request is sent, including each retry attempt.
+// It may modify req, e.g. to add tracing headers.
+type BeforeRequestHook func(ctx context.Context, req *http.Request, info RequestInfo)
+
+// AfterResponseHook is called after every request completes, including each retry attempt.
+type AfterResponseHook func(ctx context.Context, info ResponseInfo)
+
+// opDoer sends requests of a single operation through the client hooks.
+type opDoer struct {
+	c  *VercelClient
+	op string
+}
+
+// doer returns an HTTP doer attributing its requests to op.
+func (c *VercelClient) doer(op string) opDoer {
+	return opDoer{c: c, op: op}
+}
+
+func (d opDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	info := RequestInfo{
+		Operation: d.op,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+	}
+	for _, hook := range d.c.beforeRequest {
+		hook(ctx, req, info)
+	}
+
+	start := time.Now()
+	res, err := d.c.settings().httpClient.Do(req)
+	result := ResponseInfo{
+		RequestInfo: info,
+		Latency:     time.Since(start),
+		Err:         err,
+	}
+	if res != nil {
+		result.StatusCode = res.StatusCode
+	}
+	d.c.settings().logger.DebugContext(ctx, "vercel api call",
+		"operation", d.op,
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", result.StatusCode,
+		"latency", result.Latency,
+		"error", err,
+	)
+	for _, hook := range d.c.afterResponse {
+		hook(ctx, result)
+	}
+	return res, err
+}
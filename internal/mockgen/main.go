@@ -0,0 +1,304 @@
+// Command mockgen generates a function-field mock of an interface declared in a single Go file.
+//
+// Every method of the interface, including methods of interfaces embedded from the same file,
+// becomes a method forwarding to a field named after it with a Func suffix. The generated code
+// relies on a record method and a notMocked function declared by hand in the target package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	src := flag.String("src", "", "file declaring the interface")
+	iface := flag.String("iface", "", "name of the interface to mock")
+	importPath := flag.String("import", "", "import path of the package declaring the interface")
+	pkg := flag.String("pkg", "", "package name of the generated file")
+	typeName := flag.String("type", "Client", "name of the mock type")
+	out := flag.String("out", "", "output file")
+	flag.Parse()
+
+	if *src == "" || *iface == "" || *importPath == "" || *pkg == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	code, err := generate(*src, *iface, *importPath, *pkg, *typeName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	name    string
+	params  []*ast.Field
+	results []*ast.Field
+}
+
+func generate(src, iface, importPath, pkg, typeName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, src, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			if it, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = it
+			}
+		}
+		return true
+	})
+
+	methods, err := collect(interfaces, iface)
+	if err != nil {
+		return nil, err
+	}
+
+	srcPkg := path.Base(importPath)
+	g := &generator{srcPkg: srcPkg, used: map[string]bool{}}
+	var body bytes.Buffer
+	g.writeType(&body, typeName, methods)
+	for _, m := range methods {
+		g.writeMethod(&body, typeName, m)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by internal/mockgen from %s; DO NOT EDIT.\n\n", path.Base(src))
+	var std, others []string
+	for _, imp := range file.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		if !g.used[path.Base(p)] {
+			continue
+		}
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			others = append(others, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	if g.used[srcPkg] {
+		others = append(others, importPath)
+	}
+	slices.Sort(std)
+	slices.Sort(others)
+
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", pkg)
+	for _, p := range std {
+		fmt.Fprintf(&buf, "\t%q\n", p)
+	}
+	if len(std) > 0 && len(others) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, p := range others {
+		fmt.Fprintf(&buf, "\t%q\n", p)
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// collect returns the methods of the named interface, expanding embedded interfaces in declaration order.
+func collect(interfaces map[string]*ast.InterfaceType, name string) ([]method, error) {
+	it, ok := interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", name)
+	}
+	var methods []method
+	for _, field := range it.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.FuncType:
+			m := method{name: field.Names[0].Name, params: t.Params.List}
+			if t.Results != nil {
+				m.results = t.Results.List
+			}
+			methods = append(methods, m)
+		case *ast.Ident:
+			embedded, err := collect(interfaces, t.Name)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+		default:
+			return nil, fmt.Errorf("unsupported interface element in %s", name)
+		}
+	}
+	return methods, nil
+}
+
+type generator struct {
+	srcPkg string
+	used   map[string]bool
+}
+
+// expr renders a type expression, qualifying exported identifiers of the source package.
+// Rewritten nodes get fresh identifiers and are rendered with types.ExprString, which ignores
+// positions: printing them with the source file set could break lines inside "pkg.Type".
+func (g *generator) expr(e ast.Expr) string {
+	var qualify func(ast.Expr) ast.Expr
+	qualify = func(e ast.Expr) ast.Expr {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if ast.IsExported(t.Name) {
+				g.used[g.srcPkg] = true
+				return &ast.SelectorExpr{X: ast.NewIdent(g.srcPkg), Sel: ast.NewIdent(t.Name)}
+			}
+			return t
+		case *ast.SelectorExpr:
+			g.used[t.X.(*ast.Ident).Name] = true
+			return t
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: qualify(t.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: t.Len, Elt: qualify(t.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: qualify(t.Key), Value: qualify(t.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: qualify(t.Elt)}
+		case *ast.IndexExpr:
+			return &ast.IndexExpr{X: qualify(t.X), Index: qualify(t.Index)}
+		case *ast.IndexListExpr:
+			indices := make([]ast.Expr, len(t.Indices))
+			for i, index := range t.Indices {
+				indices[i] = qualify(index)
+			}
+			return &ast.IndexListExpr{X: qualify(t.X), Indices: indices}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: t.Dir, Value: qualify(t.Value)}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: g.qualifyFields(t.Params, qualify), Results: g.qualifyFields(t.Results, qualify)}
+		}
+		return e
+	}
+
+	return types.ExprString(qualify(e))
+}
+
+func (g *generator) qualifyFields(list *ast.FieldList, qualify func(ast.Expr) ast.Expr) *ast.FieldList {
+	if list == nil {
+		return nil
+	}
+	fields := &ast.FieldList{}
+	for _, f := range list.List {
+		fields.List = append(fields.List, &ast.Field{Names: f.Names, Type: qualify(f.Type)})
+	}
+	return fields
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func (g *generator) params(m method) []param {
+	var params []param
+	for _, field := range m.params {
+		_, variadic := field.Type.(*ast.Ellipsis)
+		typ := g.expr(field.Type)
+		if len(field.Names) == 0 {
+			params = append(params, param{name: fmt.Sprintf("p%d", len(params)), typ: typ, variadic: variadic})
+		}
+		for _, name := range field.Names {
+			params = append(params, param{name: name.Name, typ: typ, variadic: variadic})
+		}
+	}
+	return params
+}
+
+func (g *generator) results(m method) []string {
+	var results []string
+	for _, field := range m.results {
+		typ := g.expr(field.Type)
+		for range max(1, len(field.Names)) {
+			results = append(results, typ)
+		}
+	}
+	return results
+}
+
+func signature(params []param, results []string) string {
+	list := make([]string, len(params))
+	for i, p := range params {
+		list[i] = p.name + " " + p.typ
+	}
+	sig := "(" + strings.Join(list, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+func (g *generator) writeType(buf *bytes.Buffer, typeName string, methods []method) {
+	fmt.Fprintf(buf, "// %s is a mock whose methods forward to the function field of the same name suffixed with Func.\n", typeName)
+	buf.WriteString("// Calling a method whose field is nil returns ErrNotMocked.\n")
+	fmt.Fprintf(buf, "type %s struct {\n\tcalls callLog\n\n", typeName)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func%s\n", m.name, signature(g.params(m), g.results(m)))
+	}
+	buf.WriteString("}\n\n")
+}
+
+func (g *generator) writeMethod(buf *bytes.Buffer, typeName string, m method) {
+	params := g.params(m)
+	results := g.results(m)
+
+	args := make([]string, len(params))
+	callArgs := make([]string, len(params))
+	for i, p := range params {
+		args[i] = p.name
+		callArgs[i] = p.name
+		if p.variadic {
+			callArgs[i] += "..."
+		}
+	}
+
+	fmt.Fprintf(buf, "// %s records the call and forwards it to %sFunc.\n", m.name, m.name)
+	fmt.Fprintf(buf, "func (m *%s) %s%s {\n", typeName, m.name, signature(params, results))
+	fmt.Fprintf(buf, "\tm.calls.record(%q, %s)\n", m.name, strings.Join(append([]string{}, args...), ", "))
+	fmt.Fprintf(buf, "\tif m.%sFunc == nil {\n", m.name)
+
+	zeros := make([]string, len(results))
+	for i, typ := range results {
+		switch {
+		case typ == "error":
+			zeros[i] = fmt.Sprintf("notMocked(%q)", m.name)
+		case strings.HasPrefix(typ, "iter.Seq2[") && strings.HasSuffix(typ, ", error]"):
+			elem := strings.TrimSuffix(strings.TrimPrefix(typ, "iter.Seq2["), ", error]")
+			zeros[i] = fmt.Sprintf("func(yield func(%s, error) bool) {\n\t\t\tvar zero %s\n\t\t\tyield(zero, notMocked(%q))\n\t\t}", elem, elem, m.name)
+		default:
+			fmt.Fprintf(buf, "\t\tvar r%d %s\n", i, typ)
+			zeros[i] = fmt.Sprintf("r%d", i)
+		}
+	}
+	if len(results) == 0 {
+		buf.WriteString("\t\treturn\n\t}\n")
+		fmt.Fprintf(buf, "\tm.%sFunc(%s)\n}\n\n", m.name, strings.Join(callArgs, ", "))
+		return
+	}
+	fmt.Fprintf(buf, "\t\treturn %s\n\t}\n", strings.Join(zeros, ", "))
+	fmt.Fprintf(buf, "\treturn m.%sFunc(%s)\n}\n\n", m.name, strings.Join(callArgs, ", "))
+}
@@ -0,0 +1,249 @@
+package vercelgo
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// StreamDeploymentEvents follows the build events of a deployment as they are produced.
+// The events already emitted are yielded first, then new ones as Vercel streams them,
+// until the build finishes and Vercel closes the stream or ctx is cancelled.
+// An error ends the iteration.
+func (c *VercelClient) StreamDeploymentEvents(ctx context.Context, deploymentId, teamId string) iter.Seq2[schemas.DeployLogsResponse, error] {
+	return func(yield func(schemas.DeployLogsResponse, error) bool) {
+		query := url.Values{"teamId": {c.team(teamId)}, "follow": {"1"}, "direction": {"forward"}}
+		stream, err := c.openStream(ctx, "StreamDeploymentEvents", c.url(query, "/v3/deployments/%s/events", deploymentId))
+		if err != nil {
+			yield(schemas.DeployLogsResponse{}, fmt.Errorf("stream deployment events error: %w", err))
+			return
+		}
+		defer stream.Close()
+
+		// The stream is newline-delimited JSON, which a json.Decoder reads one value at a time.
+		dec := json.NewDecoder(stream)
+		for {
+			var event schemas.DeployLogsResponse
+			if err := dec.Decode(&event); err != nil {
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if ctx.Err() != nil {
+					err = context.Cause(ctx)
+				}
+				yield(schemas.DeployLogsResponse{}, fmt.Errorf("read deployment event error: %w", err))
+				return
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+// logsPageSize is the number of events requested per page of deployment logs.
+const logsPageSize = 100
+
+// IterDeploymentLogs returns an iterator over the build events of a deployment, fetching further pages as needed.
+// opts selects the events by date, type and count and sets their order; nil returns every event, oldest first.
+// Since pages are requested by date, iteration fails when more events share a millisecond than fit in a page.
+func (c *VercelClient) IterDeploymentLogs(ctx context.Context, deploymentId, teamId string, opts *schemas.DeploymentLogsOptions) iter.Seq2[schemas.DeployLogsResponse, error] {
+	if opts == nil {
+		opts = &schemas.DeploymentLogsOptions{}
+	}
+	return func(yield func(schemas.DeployLogsResponse, error) bool) {
+		// Pages are requested from the date of the last event returned, which is included again
+		// in case several events share it: the ones already returned are skipped.
+		var last int64
+		var atLast int
+		pages := Paginate(ctx, func(ctx context.Context, cursor int64) ([]schemas.DeployLogsResponse, schemas.Pagination, error) {
+			events, err := c.listDeploymentLogsPage(ctx, deploymentId, teamId, opts, cursor)
+			if err != nil {
+				return nil, schemas.Pagination{}, err
+			}
+			full := len(events) == logsPageSize
+
+			for skip := atLast; cursor != 0 && skip > 0 && len(events) > 0 && events[0].Created == cursor; skip-- {
+				events = events[1:]
+			}
+			for _, event := range events {
+				if event.Created == last {
+					atLast++
+				} else {
+					last, atLast = event.Created, 1
+				}
+			}
+
+			var pagination schemas.Pagination
+			if full {
+				// Pages start at a date, so a full page of events sharing the date of the cursor
+				// cannot be followed by another one: the next page would return the same events.
+				if last == cursor {
+					return nil, schemas.Pagination{}, fmt.Errorf("more than %d deployment events share the date %d: remaining events cannot be listed", logsPageSize, cursor)
+				}
+				pagination.Next = last
+			}
+			return events, pagination, nil
+		})
+
+		returned := 0
+		for event, err := range pages {
+			if err != nil {
+				yield(event, err)
+				return
+			}
+			if len(opts.Types) > 0 && !slices.Contains(opts.Types, event.Type) {
+				continue
+			}
+			if !yield(event, nil) {
+				return
+			}
+			returned++
+			if opts.Limit > 0 && returned >= opts.Limit {
+				return
+			}
+		}
+	}
+}
+
+// ListDeploymentLogsAll returns the build events of a deployment selected by opts, across every page.
+func (c *VercelClient) ListDeploymentLogsAll(ctx context.Context, deploymentId, teamId string, opts *schemas.DeploymentLogsOptions) ([]schemas.DeployLogsResponse, error) {
+	return Collect(c.IterDeploymentLogs(ctx, deploymentId, teamId, opts))
+}
+
+// listDeploymentLogsPage fetches the page of events of a deployment starting at the date cursor,
+// in the direction of opts, or the first page when cursor is zero.
+func (c *VercelClient) listDeploymentLogsPage(ctx context.Context, deploymentId, teamId string, opts *schemas.DeploymentLogsOptions, cursor int64) ([]schemas.DeployLogsResponse, error) {
+	direction := cmp.Or(opts.Direction, "forward")
+	since, until := opts.Since, opts.Until
+	if cursor != 0 && direction == "backward" {
+		until = cursor
+	} else if cursor != 0 {
+		since = cursor
+	}
+
+	query := url.Values{
+		"teamId":    {c.team(teamId)},
+		"direction": {direction},
+		"limit":     {strconv.Itoa(logsPageSize)},
+	}
+	if since != 0 {
+		query.Set("since", strconv.FormatInt(since, 10))
+	}
+	if until != 0 {
+		query.Set("until", strconv.FormatInt(until, 10))
+	}
+
+	response, status, err := doRequest[[]schemas.DeployLogsResponse](
+		ctx,
+		c,
+		"GetDeploymentLogs",
+		"GET",
+		c.url(query, "/v3/deployments/%s/events", deploymentId),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment logs error: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to get deployment logs with code %d", status)
+	}
+	return response, nil
+}
+
+// LogFormat is the format of an exported deployment log.
+type LogFormat string
+
+const (
+	// LogFormatJSONL writes one DeployLogsResponse JSON object per line, which ReadDeploymentLogs reads back.
+	LogFormatJSONL LogFormat = "jsonl"
+	// LogFormatText writes every line of output prefixed with its UTC timestamp, marking the lines
+	// written to stderr with "[stderr]" and exit events with "[exit <status code>]".
+	// Events without text take a line with the prefix only.
+	LogFormatText LogFormat = "text"
+)
+
+// ExportDeploymentLogs writes the build events of a deployment selected by opts to w in the given format.
+func (c *VercelClient) ExportDeploymentLogs(ctx context.Context, w io.Writer, deploymentId, teamId string, format LogFormat, opts *schemas.DeploymentLogsOptions) error {
+	for event, err := range c.IterDeploymentLogs(ctx, deploymentId, teamId, opts) {
+		if err != nil {
+			return err
+		}
+		if err := WriteDeploymentLog(w, format, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteDeploymentLog writes a single build event to w in the given format,
+// e.g. to save the events of StreamDeploymentEvents as they arrive.
+func WriteDeploymentLog(w io.Writer, format LogFormat, event schemas.DeployLogsResponse) error {
+	switch format {
+	case LogFormatJSONL:
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal deployment event error: %w", err)
+		}
+		_, err = w.Write(append(line, '\n'))
+		return err
+
+	case LogFormatText:
+		date := cmp.Or(event.Payload.Date, event.Created)
+		prefix := time.UnixMilli(date).UTC().Format("2006-01-02T15:04:05.000Z07:00")
+		switch event.Type {
+		case schemas.StdErr:
+			prefix += " [stderr]"
+		case schemas.Exit:
+			prefix += fmt.Sprintf(" [exit %d]", event.Payload.StatusCode)
+		}
+
+		// Every event takes at least one line, so that events without text such as exits are kept.
+		text := strings.TrimSuffix(event.Payload.Text, "\n")
+		if text == "" {
+			_, err := io.WriteString(w, prefix+"\n")
+			return err
+		}
+		var b strings.Builder
+		for line := range strings.Lines(text) {
+			fmt.Fprintf(&b, "%s %s\n", prefix, strings.TrimSuffix(line, "\n"))
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+}
+
+// ReadDeploymentLogs returns an iterator over the build events written to r in the LogFormatJSONL format.
+// An error ends the iteration.
+func ReadDeploymentLogs(r io.Reader) iter.Seq2[schemas.DeployLogsResponse, error] {
+	return func(yield func(schemas.DeployLogsResponse, error) bool) {
+		dec := json.NewDecoder(r)
+		for n := 1; ; n++ {
+			var event schemas.DeployLogsResponse
+			if err := dec.Decode(&event); err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(schemas.DeployLogsResponse{}, fmt.Errorf("read deployment event %d error: %w", n, err))
+				}
+				return
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
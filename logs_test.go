@@ -0,0 +1,332 @@
+package vercelgo_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/vercelgotest"
+)
+
+// addDatedEvents adds a stdout event to a new deployment for each of the given dates,
+// with the index of the event as text, and returns the ID of the deployment.
+func addDatedEvents(t *testing.T, s *vercelgotest.Server, teamId, projectId string, dates []int64) string {
+	t.Helper()
+	// A deployment that is already ready has no event of its own.
+	deployment := s.AddDeployment(teamId, projectId, schemas.DeploymentResponse{ReadyState: schemas.ReadyStateReady})
+	events := make([]schemas.DeployLogsResponse, len(dates))
+	for i, date := range dates {
+		events[i] = schemas.DeployLogsResponse{
+			Type:    schemas.Stdout,
+			Created: date,
+			Payload: schemas.DeployLogPayload{Text: strconv.Itoa(i)},
+		}
+	}
+	s.AddEvents(deployment.Id, events...)
+	return deployment.Id
+}
+
+// eventTexts returns the text of every event.
+func eventTexts(events []schemas.DeployLogsResponse) []string {
+	texts := make([]string, len(events))
+	for i, event := range events {
+		texts[i] = event.Payload.Text
+	}
+	return texts
+}
+
+// indexes returns the decimal strings of [0, n).
+func indexes(n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = strconv.Itoa(i)
+	}
+	return s
+}
+
+func reversed(s []string) []string {
+	s = slices.Clone(s)
+	slices.Reverse(s)
+	return s
+}
+
+// stateEvents returns the texts of the events recorded as a deployment goes through states.
+func stateEvents(states ...schemas.ReadyState) []string {
+	texts := make([]string, len(states))
+	for i, state := range states {
+		texts[i] = "Deployment state changed to " + string(state)
+	}
+	return texts
+}
+
+func TestStreamDeploymentEvents(t *testing.T) {
+	s, team, project := newProject(t)
+	deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{})
+
+	var events []schemas.DeployLogsResponse
+	for event, err := range s.Client().StreamDeploymentEvents(context.Background(), deployment.Id, team.ID) {
+		if err != nil {
+			t.Fatalf("StreamDeploymentEvents: %v", err)
+		}
+		events = append(events, event)
+	}
+	// The fake server builds the deployment while it is followed and ends the stream once it is ready.
+	want := stateEvents(schemas.ReadyStateQueued, schemas.ReadyStateBuilding, schemas.ReadyStateReady)
+	if got := eventTexts(events); !slices.Equal(got, want) {
+		t.Errorf("streamed events %v, want %v", got, want)
+	}
+	if len(events) > 0 && events[len(events)-1].Type != schemas.Exit {
+		t.Errorf("last event type = %q, want %q", events[len(events)-1].Type, schemas.Exit)
+	}
+}
+
+func TestStreamDeploymentEventsCancel(t *testing.T) {
+	s, team, project := newProject(t)
+	// The deployment never reaches a final state, so the stream only ends with ctx.
+	deployment := s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{},
+		schemas.ReadyStateQueued, schemas.ReadyStateBuilding)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var texts []string
+	var err error
+	for event, e := range s.Client().StreamDeploymentEvents(ctx, deployment.Id, team.ID) {
+		if e != nil {
+			err = e
+			break
+		}
+		texts = append(texts, event.Payload.Text)
+		if event.Payload.Text == stateEvents(schemas.ReadyStateBuilding)[0] {
+			cancel()
+		}
+	}
+	if want := stateEvents(schemas.ReadyStateQueued, schemas.ReadyStateBuilding); !slices.Equal(texts, want) {
+		t.Errorf("streamed events %v, want %v", texts, want)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("StreamDeploymentEvents error = %v, want context.Canceled", err)
+	}
+}
+
+func TestListDeploymentLogsAll(t *testing.T) {
+	const total = 251
+	distinct := make([]int64, total)
+	for i := range distinct {
+		distinct[i] = 1_000_000 + int64(i)
+	}
+	// Events sharing a date cross the end of the first and second forward pages,
+	// which end with the events 99 and 196.
+	shared := slices.Clone(distinct)
+	for i := 97; i < 102; i++ {
+		shared[i] = shared[97]
+	}
+	for i := 195; i < 199; i++ {
+		shared[i] = shared[195]
+	}
+	// The next page starts with events sharing the date of the last three events of the first page.
+	sharedFromEnd := slices.Clone(distinct)
+	for i := 97; i < 110; i++ {
+		sharedFromEnd[i] = sharedFromEnd[97]
+	}
+
+	for _, dates := range []struct {
+		name  string
+		dates []int64
+	}{
+		{"distinct dates", distinct},
+		{"dates shared across pages", shared},
+		{"dates shared by a page end", sharedFromEnd},
+	} {
+		for _, direction := range []string{"forward", "backward"} {
+			t.Run(dates.name+"/"+direction, func(t *testing.T) {
+				s, team, project := newProject(t)
+				deploymentId := addDatedEvents(t, s, team.ID, project.ID, dates.dates)
+
+				events, err := s.Client().ListDeploymentLogsAll(context.Background(), deploymentId, team.ID,
+					&schemas.DeploymentLogsOptions{Direction: direction})
+				if err != nil {
+					t.Fatalf("ListDeploymentLogsAll: %v", err)
+				}
+				want := indexes(total)
+				if direction == "backward" {
+					want = reversed(want)
+				}
+				if got := eventTexts(events); !slices.Equal(got, want) {
+					t.Errorf("got events %v, want %v", got, want)
+				}
+				if n := countRequests(s, "GET", "/v3/deployments/"+deploymentId+"/events"); n != 3 {
+					t.Errorf("got %d page requests, want 3", n)
+				}
+			})
+		}
+	}
+}
+
+func TestListDeploymentLogsAllTooManySharedDates(t *testing.T) {
+	s, team, project := newProject(t)
+	dates := make([]int64, 150)
+	for i := range dates {
+		dates[i] = 1_000_000
+	}
+	deploymentId := addDatedEvents(t, s, team.ID, project.ID, dates)
+
+	_, err := s.Client().ListDeploymentLogsAll(context.Background(), deploymentId, team.ID, nil)
+	if err == nil || !strings.Contains(err.Error(), "share the date") {
+		t.Errorf("ListDeploymentLogsAll error = %v, want an error about events sharing a date", err)
+	}
+}
+
+func TestListDeploymentLogsAllFilters(t *testing.T) {
+	s, team, project := newProject(t)
+	dates := make([]int64, 150)
+	for i := range dates {
+		dates[i] = 1_000_000 + int64(i)
+	}
+	deploymentId := addDatedEvents(t, s, team.ID, project.ID, dates)
+	s.AddEvents(deploymentId, schemas.DeployLogsResponse{
+		Type:    schemas.StdErr,
+		Created: 1_000_120,
+		Payload: schemas.DeployLogPayload{Text: "error"},
+	})
+
+	tests := []struct {
+		name string
+		opts schemas.DeploymentLogsOptions
+		want []string
+	}{
+		{
+			name: "since and until",
+			opts: schemas.DeploymentLogsOptions{Since: 1_000_010, Until: 1_000_012},
+			want: []string{"10", "11", "12"},
+		},
+		{
+			name: "limit",
+			opts: schemas.DeploymentLogsOptions{Limit: 3, Direction: "backward"},
+			want: []string{"149", "148", "147"},
+		},
+		{
+			name: "types",
+			opts: schemas.DeploymentLogsOptions{Types: []schemas.DeploymentLogType{schemas.StdErr}},
+			want: []string{"error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := s.Client().ListDeploymentLogsAll(context.Background(), deploymentId, team.ID, &tt.opts)
+			if err != nil {
+				t.Fatalf("ListDeploymentLogsAll: %v", err)
+			}
+			if got := eventTexts(events); !slices.Equal(got, tt.want) {
+				t.Errorf("got events %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteDeploymentLogText(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 6e6, time.UTC).UnixMilli()
+	tests := []struct {
+		name  string
+		event schemas.DeployLogsResponse
+		want  string
+	}{
+		{
+			name:  "stdout lines",
+			event: schemas.DeployLogsResponse{Type: schemas.Stdout, Created: created, Payload: schemas.DeployLogPayload{Text: "Installing\nBuilding\n"}},
+			want:  "2026-01-02T03:04:05.006Z Installing\n2026-01-02T03:04:05.006Z Building\n",
+		},
+		{
+			name:  "payload date",
+			event: schemas.DeployLogsResponse{Type: schemas.Stdout, Created: created, Payload: schemas.DeployLogPayload{Text: "Done", Date: created + 1000}},
+			want:  "2026-01-02T03:04:06.006Z Done\n",
+		},
+		{
+			name:  "stderr",
+			event: schemas.DeployLogsResponse{Type: schemas.StdErr, Created: created, Payload: schemas.DeployLogPayload{Text: "Error: build failed"}},
+			want:  "2026-01-02T03:04:05.006Z [stderr] Error: build failed\n",
+		},
+		{
+			name:  "exit without text",
+			event: schemas.DeployLogsResponse{Type: schemas.Exit, Created: created, Payload: schemas.DeployLogPayload{StatusCode: 1}},
+			want:  "2026-01-02T03:04:05.006Z [exit 1]\n",
+		},
+		{
+			name:  "exit with text",
+			event: schemas.DeployLogsResponse{Type: schemas.Exit, Created: created, Payload: schemas.DeployLogPayload{Text: "Build completed"}},
+			want:  "2026-01-02T03:04:05.006Z [exit 0] Build completed\n",
+		},
+		{
+			name:  "command without text",
+			event: schemas.DeployLogsResponse{Type: schemas.Command, Created: created},
+			want:  "2026-01-02T03:04:05.006Z\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := vercelgo.WriteDeploymentLog(&b, vercelgo.LogFormatText, tt.event); err != nil {
+				t.Fatalf("WriteDeploymentLog: %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportDeploymentLogsJSONLRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s, team, project := newProject(t)
+	deploymentId := addDatedEvents(t, s, team.ID, project.ID, []int64{1_000_000, 1_000_001, 1_000_001})
+	s.AddEvents(deploymentId,
+		schemas.DeployLogsResponse{Type: schemas.StdErr, Payload: schemas.DeployLogPayload{Id: "evt_1", Text: "warning: \"quoted\"\n\tindented"}},
+		schemas.DeployLogsResponse{Type: schemas.Exit, Payload: schemas.DeployLogPayload{StatusCode: 1}},
+	)
+	c := s.Client()
+
+	var buf bytes.Buffer
+	if err := c.ExportDeploymentLogs(ctx, &buf, deploymentId, team.ID, vercelgo.LogFormatJSONL, nil); err != nil {
+		t.Fatalf("ExportDeploymentLogs: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 5 {
+		t.Errorf("exported %d lines, want one per event", lines)
+	}
+	read, err := vercelgo.Collect(vercelgo.ReadDeploymentLogs(&buf))
+	if err != nil {
+		t.Fatalf("ReadDeploymentLogs: %v", err)
+	}
+
+	want, err := c.ListDeploymentLogsAll(ctx, deploymentId, team.ID, nil)
+	if err != nil {
+		t.Fatalf("ListDeploymentLogsAll: %v", err)
+	}
+	if !slices.Equal(read, want) {
+		t.Errorf("read back %+v, want %+v", read, want)
+	}
+}
+
+func TestReadDeploymentLogsInvalid(t *testing.T) {
+	r := strings.NewReader(`{"type":"stdout","created":1,"payload":{"text":"ok"}}` + "\n" + `{"type":` + "\n")
+	var events []schemas.DeployLogsResponse
+	var err error
+	for event, e := range vercelgo.ReadDeploymentLogs(r) {
+		if e != nil {
+			err = e
+			break
+		}
+		events = append(events, event)
+	}
+	if len(events) != 1 || events[0].Payload.Text != "ok" {
+		t.Errorf("read %+v before the invalid line, want the first event", events)
+	}
+	if err == nil || !strings.Contains(err.Error(), "event 2") {
+		t.Errorf("error = %v, want an error about event 2", err)
+	}
+}
@@ -0,0 +1,119 @@
+package vercelgo
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a VercelClient created with NewClient.
+type Option func(*VercelClient)
+
+// WithToken sets the bearer token used to authenticate requests.
+func WithToken(token string) Option {
+	return func(c *VercelClient) {
+		c.Token = token
+	}
+}
+
+// WithBaseURL overrides the Vercel API base URL, e.g. to target an httptest.Server or an API proxy.
+// The URL may contain a path prefix; every endpoint path is appended to it.
+func WithBaseURL(baseURL string) Option {
+	return func(c *VercelClient) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithTeamID sets the team used by methods called with an empty teamId.
+func WithTeamID(teamId string) Option {
+	return func(c *VercelClient) {
+		c.teamID = teamId
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send every request, including file uploads.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *VercelClient) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport sets the RoundTripper used by the client HTTP client,
+// e.g. to route requests through a proxy or a test transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *VercelClient) {
+		httpClient := *c.httpClient
+		httpClient.Transport = transport
+		c.httpClient = &httpClient
+	}
+}
+
+// WithTimeout sets the timeout applied to each API request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *VercelClient) {
+		c.timeout = timeout
+	}
+}
+
+// WithUploadTimeout sets how long a deployment file upload may go without sending any data,
+// or without a response once sent, before it is aborted and retried. Uploads of large files
+// are not limited as a whole, so that they never time out while data is still flowing.
+// Zero disables the timeout.
+func WithUploadTimeout(timeout time.Duration) Option {
+	return func(c *VercelClient) {
+		c.uploadTimeout = timeout
+	}
+}
+
+// WithUploadConcurrency sets how many deployment files are uploaded at once.
+func WithUploadConcurrency(n int) Option {
+	return func(c *VercelClient) {
+		if n > 0 {
+			c.uploadConcurrency = n
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *VercelClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+// Pass RetryPolicy{} to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *VercelClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithBeforeRequest adds a hook called before every request, including file uploads.
+// Hooks run in the order they were added.
+func WithBeforeRequest(hook BeforeRequestHook) Option {
+	return func(c *VercelClient) {
+		c.beforeRequest = append(c.beforeRequest, hook)
+	}
+}
+
+// WithAfterResponse adds a hook called after every request, including file uploads.
+// Hooks run in the order they were added.
+func WithAfterResponse(hook AfterResponseHook) Option {
+	return func(c *VercelClient) {
+		c.afterResponse = append(c.afterResponse, hook)
+	}
+}
+
+// WithLogger sets the logger receiving debug events for API calls, file uploads,
+// deployment polling and deletions. Request headers, and thus the token, are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *VercelClient) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package vercelgo
+
+import (
+	"context"
+	"iter"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// PageFunc fetches one page of a list endpoint.
+// cursor is zero for the first page and the Next value of the previous page afterwards.
+type PageFunc[T any] func(ctx context.Context, cursor int64) ([]T, schemas.Pagination, error)
+
+// Paginate returns an iterator over every item of a paginated list endpoint,
+// following Pagination.Next until the last page. Iteration stops after the first error.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var cursor int64
+		for {
+			items, pagination, err := fetch(ctx, cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || pagination.Next == 0 || pagination.Next == cursor {
+				return
+			}
+			cursor = pagination.Next
+		}
+	}
+}
+
+// Collect gathers every item of seq into a slice, stopping at the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,123 @@
+package vercelgo_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/vercelgotest"
+)
+
+// itemCount spans three pages of the fake server, which returns 20 items per page.
+const itemCount = 45
+
+// names returns name0 to name{n-1}, newest first when they are created in order.
+func names(prefix string, n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = fmt.Sprintf("%s%d", prefix, n-1-i)
+	}
+	return s
+}
+
+// checkPages checks that got lists want and that listing it took one request per page of path.
+func checkPages(t *testing.T, s *vercelgotest.Server, path string, got, want []string) {
+	t.Helper()
+	if !slices.Equal(got, want) {
+		t.Errorf("listed %v, want %v", got, want)
+	}
+	if n, pages := countRequests(s, "GET", path), (len(want)+19)/20; n != pages {
+		t.Errorf("got %d page requests, want %d", n, pages)
+	}
+}
+
+func TestListTeamsAll(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	for i := range itemCount {
+		s.AddTeam(schemas.Team{Slug: fmt.Sprintf("team%d", i)})
+	}
+
+	teams, err := s.Client().ListTeamsAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListTeamsAll: %v", err)
+	}
+	var got []string
+	for _, team := range teams {
+		got = append(got, team.Slug)
+	}
+	checkPages(t, s, "/v1/teams", got, names("team", itemCount))
+}
+
+func TestListProjectsAll(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	for i := range itemCount {
+		s.AddProject(team.ID, schemas.Project{Name: fmt.Sprintf("site%d", i)})
+	}
+
+	projects, err := s.Client().ListProjectsAll(context.Background(), team.ID, nil)
+	if err != nil {
+		t.Fatalf("ListProjectsAll: %v", err)
+	}
+	var got []string
+	for _, project := range projects {
+		got = append(got, project.Name)
+	}
+	checkPages(t, s, "/v10/projects", got, names("site", itemCount))
+}
+
+func TestListDeploymentsAll(t *testing.T) {
+	s, team, project := newProject(t)
+	for i := range itemCount {
+		s.AddDeployment(team.ID, project.ID, schemas.DeploymentResponse{Id: fmt.Sprintf("dpl_%d", i)})
+	}
+
+	deployments, err := s.Client().ListDeploymentsAll(context.Background(), project.ID, team.ID)
+	if err != nil {
+		t.Fatalf("ListDeploymentsAll: %v", err)
+	}
+	var got []string
+	for _, deployment := range deployments {
+		got = append(got, deployment.Id)
+	}
+	checkPages(t, s, "/v6/deployments", got, names("dpl_", itemCount))
+}
+
+func TestListProjectDomainsAll(t *testing.T) {
+	s, team, project := newProject(t)
+	for i := range itemCount {
+		s.AddDomain(project.ID, schemas.DomainInfo{Name: fmt.Sprintf("domain%d", i), CreatedAt: 1_000 + int64(i)})
+	}
+	asc, desc := "ASC", "DESC"
+	since, until := int64(1_002), int64(1_040)
+	bounded := names("domain", 40)[:37] // domain39 to domain3
+
+	tests := []struct {
+		name string
+		opts *schemas.Options
+		want []string
+	}{
+		{name: "default", want: names("domain", itemCount)},
+		{name: "descending with bounds", opts: &schemas.Options{Order: &desc, Since: &since, Until: &until}, want: bounded},
+		{name: "ascending with bounds", opts: &schemas.Options{Order: &asc, Since: &since, Until: &until}, want: reversed(bounded)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domains, err := s.Client().ListProjectDomainsAll(context.Background(), project.ID, team.ID, tt.opts)
+			if err != nil {
+				t.Fatalf("ListProjectDomainsAll: %v", err)
+			}
+			var got []string
+			for _, domain := range domains {
+				got = append(got, domain.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("listed %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
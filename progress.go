@@ -0,0 +1,109 @@
+package vercelgo
+
+import "sync"
+
+// ProgressKind identifies the step of a deployment a DeployProgress event reports.
+type ProgressKind string
+
+const (
+	// ProgressFilesDiscovered is reported once the files to deploy are known, with TotalFiles and TotalBytes set.
+	ProgressFilesDiscovered ProgressKind = "files_discovered"
+	// ProgressFileHashed is reported after each file is hashed, with File, Sha and Size set.
+	// TotalBytes is corrected for files whose size was not known when they were discovered,
+	// such as DeployFile entries read with Open, or files that changed size since.
+	ProgressFileHashed ProgressKind = "file_hashed"
+	// ProgressUploadStarted is reported when the upload of File starts.
+	ProgressUploadStarted ProgressKind = "upload_started"
+	// ProgressUploadFinished is reported when File is uploaded.
+	ProgressUploadFinished ProgressKind = "upload_finished"
+	// ProgressUploadSkipped is reported for each File that Vercel already has
+	// or that shares its content with another uploaded file.
+	ProgressUploadSkipped ProgressKind = "upload_skipped"
+	// ProgressDeploymentCreated is reported once the deployment is created, with DeploymentID set.
+	ProgressDeploymentCreated ProgressKind = "deployment_created"
+	// ProgressDomainLookup is reported before looking up the domains of the project with WithDomainLookup.
+	ProgressDomainLookup ProgressKind = "domain_lookup"
+)
+
+// DeployProgress is an event reported to the function set with WithProgress.
+// Besides the file it is about, every event carries the running totals of the deployment,
+// so a progress bar can be rendered from any single event.
+type DeployProgress struct {
+	Kind ProgressKind `json:"kind"`
+
+	// File, Sha and Size describe the file of a per-file event.
+	File string `json:"file,omitempty"`
+	Sha  string `json:"sha,omitempty"`
+	Size int64  `json:"size,omitempty"`
+
+	// TotalFiles and TotalBytes count every file of the deployment.
+	TotalFiles int   `json:"totalFiles"`
+	TotalBytes int64 `json:"totalBytes"`
+	// HashedFiles and HashedBytes count the files hashed so far.
+	HashedFiles int   `json:"hashedFiles"`
+	HashedBytes int64 `json:"hashedBytes"`
+	// UploadTotalFiles and UploadTotalBytes count the files that have to be uploaded.
+	UploadTotalFiles int   `json:"uploadTotalFiles"`
+	UploadTotalBytes int64 `json:"uploadTotalBytes"`
+	// UploadedFiles and UploadedBytes count the files uploaded so far.
+	UploadedFiles int   `json:"uploadedFiles"`
+	UploadedBytes int64 `json:"uploadedBytes"`
+	// SkippedFiles counts the files that did not have to be uploaded.
+	SkippedFiles int `json:"skippedFiles"`
+
+	// DeploymentID is set once the deployment is created.
+	DeploymentID string `json:"deploymentId,omitempty"`
+}
+
+// WithProgress reports the progress of the deployment to fn.
+// Calls to fn never overlap, even while files are hashed or uploaded concurrently,
+// so fn should return quickly.
+func WithProgress(fn func(DeployProgress)) DeployOption {
+	return func(cfg *deployConfig) {
+		cfg.progress = fn
+	}
+}
+
+// progress keeps the running totals of a deployment and reports them to fn, when set.
+type progress struct {
+	mu     sync.Mutex
+	fn     func(DeployProgress)
+	totals DeployProgress
+}
+
+func newProgress(fn func(DeployProgress)) *progress {
+	return &progress{fn: fn}
+}
+
+// set applies update to the totals without reporting an event.
+func (p *progress) set(update func(totals *DeployProgress)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	update(&p.totals)
+}
+
+// report applies update to the totals and reports them as an event of the given kind about file, which may be nil.
+func (p *progress) report(kind ProgressKind, file *deployFile, update func(totals *DeployProgress)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if update != nil {
+		update(&p.totals)
+	}
+	if p.fn == nil {
+		return
+	}
+
+	event := p.totals
+	event.Kind = kind
+	if file != nil {
+		event.File, event.Sha, event.Size = file.File, file.Sha, file.size
+	}
+	p.fn(event)
+}
+
+// snapshot returns the current totals.
+func (p *progress) snapshot() DeployProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.totals
+}
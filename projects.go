@@ -1,19 +1,25 @@
 package vercelgo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
+	"strconv"
 
-	"github.com/GitDocAI/vercelgo/config"
 	"github.com/GitDocAI/vercelgo/schemas"
-	"github.com/GitDocAI/vercelgo/utils"
 )
 
 // Allows to create a new project with the provided configuration.
 // It only requires the project name and team ID but more configuration can be provided to override the defaults.
 func (c *VercelClient) CreateProject(payload schemas.CreateProjectRequest, teamId string, framework schemas.VercelFramework) (*schemas.Project, error) {
+	return c.CreateProjectCtx(context.Background(), payload, teamId, framework)
+}
+
+// CreateProjectCtx is like CreateProject but uses ctx for every request it makes.
+func (c *VercelClient) CreateProjectCtx(ctx context.Context, payload schemas.CreateProjectRequest, teamId string, framework schemas.VercelFramework) (*schemas.Project, error) {
+	teamId = c.team(teamId)
 	if payload.Name == "" {
 		return nil, fmt.Errorf("project name is required")
 	}
@@ -29,9 +35,9 @@ func (c *VercelClient) CreateProject(payload schemas.CreateProjectRequest, teamI
 		return nil, fmt.Errorf("failed to marshal create project request: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/v11/projects?teamId=%s", config.BaseURL, teamId)
+	url := c.url(teamQuery(teamId), "/v11/projects")
 
-	response, status, err := utils.DoReq[schemas.Project](url, body, "POST", c.GetHeaders(), false, 15*time.Second)
+	response, status, err := doRequest[schemas.Project](ctx, c, "CreateProject", "POST", url, body)
 	if err != nil {
 		return nil, fmt.Errorf("create project error: %w", err)
 	}
@@ -45,6 +51,12 @@ func (c *VercelClient) CreateProject(payload schemas.CreateProjectRequest, teamI
 
 // Update the fields of a project using either its name or id
 func (c *VercelClient) UpdateProject(projectIdOrName string, payload schemas.CreateProjectRequest, teamId string) (*schemas.Project, error) {
+	return c.UpdateProjectCtx(context.Background(), projectIdOrName, payload, teamId)
+}
+
+// UpdateProjectCtx is like UpdateProject but uses ctx for every request it makes.
+func (c *VercelClient) UpdateProjectCtx(ctx context.Context, projectIdOrName string, payload schemas.CreateProjectRequest, teamId string) (*schemas.Project, error) {
+	teamId = c.team(teamId)
 	if projectIdOrName == "" {
 		return nil, fmt.Errorf("projectIdOrName is required")
 	}
@@ -57,9 +69,9 @@ func (c *VercelClient) UpdateProject(projectIdOrName string, payload schemas.Cre
 		return nil, fmt.Errorf("failed to marshal update project request: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/v9/projects/%s?teamId=%s", config.BaseURL, projectIdOrName, teamId)
+	url := c.url(teamQuery(teamId), "/v9/projects/%s", projectIdOrName)
 
-	response, status, err := utils.DoReq[schemas.Project](url, body, "PATCH", c.GetHeaders(), false, 15*time.Second)
+	response, status, err := doRequest[schemas.Project](ctx, c, "UpdateProject", "PATCH", url, body)
 	if err != nil {
 		return nil, fmt.Errorf("update project error: %w", err)
 	}
@@ -73,6 +85,12 @@ func (c *VercelClient) UpdateProject(projectIdOrName string, payload schemas.Cre
 
 // Delete a specific project by passing either the project id or name
 func (c *VercelClient) DeleteProject(projectIdOrName string, teamId string) error {
+	return c.DeleteProjectCtx(context.Background(), projectIdOrName, teamId)
+}
+
+// DeleteProjectCtx is like DeleteProject but uses ctx for every request it makes.
+func (c *VercelClient) DeleteProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	teamId = c.team(teamId)
 	if projectIdOrName == "" {
 		return fmt.Errorf("projectIdOrName is required")
 	}
@@ -80,9 +98,9 @@ func (c *VercelClient) DeleteProject(projectIdOrName string, teamId string) erro
 		return fmt.Errorf("teamId is required")
 	}
 
-	url := fmt.Sprintf("%s/v9/projects/%s?teamId=%s", config.BaseURL, projectIdOrName, teamId)
+	url := c.url(teamQuery(teamId), "/v9/projects/%s", projectIdOrName)
 
-	_, status, err := utils.DoReq[interface{}](url, nil, "DELETE", c.GetHeaders(), false, 15*time.Second)
+	_, status, err := doRequest[interface{}](ctx, c, "DeleteProject", "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("delete project error: %w", err)
 	}
@@ -96,6 +114,12 @@ func (c *VercelClient) DeleteProject(projectIdOrName string, teamId string) erro
 
 // PauseProject pauses a Vercel project by its ID or name
 func (c *VercelClient) PauseProject(projectIdOrName string, teamId string) error {
+	return c.PauseProjectCtx(context.Background(), projectIdOrName, teamId)
+}
+
+// PauseProjectCtx is like PauseProject but uses ctx for every request it makes.
+func (c *VercelClient) PauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	teamId = c.team(teamId)
 	if projectIdOrName == "" {
 		return fmt.Errorf("projectIdOrName is required")
 	}
@@ -103,9 +127,9 @@ func (c *VercelClient) PauseProject(projectIdOrName string, teamId string) error
 		return fmt.Errorf("teamId is required")
 	}
 
-	url := fmt.Sprintf("%s/v1/projects/%s/pause?teamId=%s", config.BaseURL, projectIdOrName, teamId)
+	url := c.url(teamQuery(teamId), "/v1/projects/%s/pause", projectIdOrName)
 
-	_, status, err := utils.DoReq[interface{}](url, nil, "POST", c.GetHeaders(), false, 15*time.Second)
+	_, status, err := doRequest[interface{}](ctx, c, "PauseProject", "POST", url, nil)
 	if err != nil {
 		return fmt.Errorf("pause project error: %w", err)
 	}
@@ -119,6 +143,12 @@ func (c *VercelClient) PauseProject(projectIdOrName string, teamId string) error
 
 // UnpauseProject unpauses (resumes) a paused Vercel project by its ID or name
 func (c *VercelClient) UnpauseProject(projectIdOrName string, teamId string) error {
+	return c.UnpauseProjectCtx(context.Background(), projectIdOrName, teamId)
+}
+
+// UnpauseProjectCtx is like UnpauseProject but uses ctx for every request it makes.
+func (c *VercelClient) UnpauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	teamId = c.team(teamId)
 	if projectIdOrName == "" {
 		return fmt.Errorf("projectIdOrName is required")
 	}
@@ -126,9 +156,9 @@ func (c *VercelClient) UnpauseProject(projectIdOrName string, teamId string) err
 		return fmt.Errorf("teamId is required")
 	}
 
-	url := fmt.Sprintf("%s/v1/projects/%s/unpause?teamId=%s", config.BaseURL, projectIdOrName, teamId)
+	url := c.url(teamQuery(teamId), "/v1/projects/%s/unpause", projectIdOrName)
 
-	_, status, err := utils.DoReq[interface{}](url, nil, "POST", c.GetHeaders(), false, 15*time.Second)
+	_, status, err := doRequest[interface{}](ctx, c, "UnpauseProject", "POST", url, nil)
 	if err != nil {
 		return fmt.Errorf("unpause project error: %w", err)
 	}
@@ -139,3 +169,43 @@ func (c *VercelClient) UnpauseProject(projectIdOrName string, teamId string) err
 
 	return nil
 }
+
+// IterProjects returns an iterator over all the projects of a team, fetching further pages as needed.
+// filter.Limit sets the page size and filter.Search filters projects by name.
+func (c *VercelClient) IterProjects(ctx context.Context, teamId string, filter *schemas.Filter) iter.Seq2[schemas.Project, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor int64) ([]schemas.Project, schemas.Pagination, error) {
+		return c.listProjectsPage(ctx, teamId, filter, cursor)
+	})
+}
+
+// ListProjectsAll returns all the projects of a team, across every page.
+func (c *VercelClient) ListProjectsAll(ctx context.Context, teamId string, filter *schemas.Filter) ([]schemas.Project, error) {
+	return Collect(c.IterProjects(ctx, teamId, filter))
+}
+
+// listProjectsPage fetches the page of projects updated before until, or the first page when until is zero.
+func (c *VercelClient) listProjectsPage(ctx context.Context, teamId string, filter *schemas.Filter, until int64) ([]schemas.Project, schemas.Pagination, error) {
+	query := teamQuery(c.team(teamId))
+	if filter != nil {
+		if filter.Limit > 0 {
+			query.Set("limit", strconv.FormatInt(filter.Limit, 10))
+		}
+		if filter.Search != "" {
+			query.Set("search", filter.Search)
+		}
+	}
+	if until != 0 {
+		query.Set("until", strconv.FormatInt(until, 10))
+	}
+
+	response, status, err := doRequest[schemas.ListProjectsResponse](ctx, c, "ListProjects", "GET", c.url(query, "/v10/projects"), nil)
+	if err != nil {
+		return nil, schemas.Pagination{}, fmt.Errorf("list projects error: %w", err)
+	}
+
+	if status != http.StatusOK {
+		return nil, schemas.Pagination{}, fmt.Errorf("failed to list projects: status %d", status)
+	}
+
+	return response.Projects, response.Pagination, nil
+}
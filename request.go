@@ -0,0 +1,59 @@
+package vercelgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/GitDocAI/vercelgo/utils"
+)
+
+// doRequest sends a JSON request to the Vercel API with the client headers and timeout,
+// decoding the response body into T. Non-2xx responses are returned as *APIError.
+// Failed attempts are retried according to the client retry policy.
+// op names the client method issuing the request and is reported to the client hooks.
+func doRequest[T any](ctx context.Context, c *VercelClient, op, method, url string, body []byte) (T, int, error) {
+	var (
+		response T
+		status   int
+	)
+	err := c.retry(ctx, isIdempotent(method), func(ctx context.Context) error {
+		ctx, cancel := withTimeout(ctx, c.settings().timeout)
+		defer cancel()
+
+		var err error
+		response, status, err = utils.DoReq[T](ctx, c.doer(op), url, body, method, c.GetHeaders())
+		return asAPIError(err)
+	})
+	return response, status, err
+}
+
+// openStream sends a GET request to the Vercel API and returns the response body for the caller
+// to read incrementally and close. No client timeout applies since streams last as long as ctx.
+// Failing to open the stream is retried according to the client retry policy.
+func (c *VercelClient) openStream(ctx context.Context, op, url string) (io.ReadCloser, error) {
+	var stream io.ReadCloser
+	err := c.retry(ctx, true, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		for k, v := range c.GetHeaders() {
+			req.Header.Set(k, v)
+		}
+
+		res, err := c.doer(op).Do(req)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			return newAPIError(req.Method, url, res.StatusCode, res.Header, body)
+		}
+		stream = res.Body
+		return nil
+	})
+	return stream, err
+}
@@ -0,0 +1,128 @@
+package vercelgo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Only idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE) are retried unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the base delay before the first retry; it doubles on every further attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed backoff delay as well as the delays requested by Vercel
+	// through the Retry-After and X-RateLimit-Reset headers. Zero leaves them uncapped.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes lists the HTTP status codes that trigger a retry.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent also retries POST and PATCH requests.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the policy used by clients created with NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retry calls fn until it succeeds, the error is not retryable or the policy runs out of attempts.
+// idempotent tells whether the request can safely be sent more than once.
+func (c *VercelClient) retry(ctx context.Context, idempotent bool, fn func(ctx context.Context) error) error {
+	policy := c.settings().retryPolicy
+	if !idempotent && !policy.RetryNonIdempotent {
+		policy.MaxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+			return err
+		}
+
+		delay, ok := policy.delay(attempt, err)
+		if !ok {
+			return err
+		}
+		c.settings().logger.DebugContext(ctx, "retrying vercel request", "attempt", attempt, "delay", delay, "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// delay returns how long to wait before the attempt following a failed one,
+// and false when err should not be retried.
+func (p RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if !slices.Contains(p.RetryableStatusCodes, apiErr.StatusCode) {
+			return 0, false
+		}
+		if apiErr.RetryAfter > 0 {
+			return p.capped(apiErr.RetryAfter), true
+		}
+		if apiErr.StatusCode == http.StatusTooManyRequests && !apiErr.RateLimit.Reset.IsZero() {
+			if wait := time.Until(apiErr.RateLimit.Reset); wait > 0 {
+				return p.capped(wait), true
+			}
+		}
+		return p.backoff(attempt), true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return p.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// capped limits a delay requested by Vercel to MaxBackoff, when set.
+func (p RetryPolicy) capped(d time.Duration) time.Duration {
+	if p.MaxBackoff > 0 {
+		return min(d, p.MaxBackoff)
+	}
+	return d
+}
+
+// backoff returns an exponential delay with jitter for the given attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff << (attempt - 1)
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
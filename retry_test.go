@@ -0,0 +1,196 @@
+package vercelgo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/vercelgotest"
+)
+
+// countRequests returns how many requests s received for method and path.
+func countRequests(s *vercelgotest.Server, method, path string) int {
+	n := 0
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+// retryPolicy returns the default retry policy with the given maximum backoff and no base delay.
+func retryPolicy(maxBackoff time.Duration) vercelgo.RetryPolicy {
+	policy := vercelgo.DefaultRetryPolicy()
+	policy.InitialBackoff = 0
+	policy.MaxBackoff = maxBackoff
+	return policy
+}
+
+func TestRetryScriptedStatuses(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	path := "/v1/teams/" + team.ID
+	s.Fail(vercelgotest.Failure{Method: "GET", Path: path, Status: http.StatusTooManyRequests})
+	s.Fail(vercelgotest.Failure{Method: "GET", Path: path, Status: http.StatusServiceUnavailable})
+
+	got, err := s.Client().GetTeamCtx(context.Background(), team.ID)
+	if err != nil {
+		t.Fatalf("GetTeamCtx: %v", err)
+	}
+	if got.ID != team.ID {
+		t.Errorf("team ID = %q, want %q", got.ID, team.ID)
+	}
+	if n := countRequests(s, "GET", path); n != 3 {
+		t.Errorf("got %d requests, want 3", n)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	path := "/v1/teams/" + team.ID
+	s.Fail(vercelgotest.Failure{Method: "GET", Path: path, Status: http.StatusServiceUnavailable, Times: 5})
+
+	_, err := s.Client().GetTeamCtx(context.Background(), team.ID)
+	if err == nil {
+		t.Fatal("GetTeamCtx succeeded, want an error")
+	}
+	if n := countRequests(s, "GET", path); n != vercelgo.DefaultRetryPolicy().MaxAttempts {
+		t.Errorf("got %d requests, want %d", n, vercelgo.DefaultRetryPolicy().MaxAttempts)
+	}
+}
+
+func TestRetryHonorsServerDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		header func() http.Header
+		status int
+	}{
+		{
+			name:   "Retry-After",
+			header: func() http.Header { return http.Header{"Retry-After": {"1"}} },
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name: "X-RateLimit-Reset",
+			header: func() http.Header {
+				// The reset is a Unix time in seconds, so the wait is between one and two seconds.
+				reset := time.Now().Unix() + 2
+				return http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(reset, 10)}}
+			},
+			status: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vercelgotest.NewServer()
+			defer s.Close()
+			team := s.AddTeam(schemas.Team{Slug: "acme"})
+			s.Fail(vercelgotest.Failure{Method: "GET", Status: tt.status, Header: tt.header()})
+
+			c := s.Client(vercelgo.WithRetryPolicy(retryPolicy(time.Minute)))
+			start := time.Now()
+			if _, err := c.GetTeamCtx(context.Background(), team.ID); err != nil {
+				t.Fatalf("GetTeamCtx: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed < time.Second {
+				t.Errorf("retried after %v, want at least 1s", elapsed)
+			}
+		})
+	}
+}
+
+func TestRetryCapsServerDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{
+			name:   "Retry-After",
+			header: http.Header{"Retry-After": {"3600"}},
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "X-RateLimit-Reset",
+			header: http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)}},
+			status: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vercelgotest.NewServer()
+			defer s.Close()
+			team := s.AddTeam(schemas.Team{Slug: "acme"})
+			s.Fail(vercelgotest.Failure{Method: "GET", Status: tt.status, Header: tt.header})
+
+			c := s.Client(vercelgo.WithRetryPolicy(retryPolicy(50 * time.Millisecond)))
+			start := time.Now()
+			if _, err := c.GetTeamCtx(context.Background(), team.ID); err != nil {
+				t.Fatalf("GetTeamCtx: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("retried after %v, want MaxBackoff to cap the delay", elapsed)
+			}
+		})
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	for _, retryNonIdempotent := range []bool{false, true} {
+		t.Run("RetryNonIdempotent="+strconv.FormatBool(retryNonIdempotent), func(t *testing.T) {
+			s := vercelgotest.NewServer()
+			defer s.Close()
+			s.Fail(vercelgotest.Failure{Method: "POST", Path: "/v11/projects", Status: http.StatusServiceUnavailable})
+
+			policy := retryPolicy(0)
+			policy.RetryNonIdempotent = retryNonIdempotent
+			c := s.Client(vercelgo.WithRetryPolicy(policy))
+			_, err := c.CreateProjectCtx(context.Background(), schemas.CreateProjectRequest{Name: "site"}, "team_1", "")
+
+			want := 1
+			if retryNonIdempotent {
+				want = 2
+				if err != nil {
+					t.Fatalf("CreateProjectCtx: %v", err)
+				}
+			} else if err == nil {
+				t.Fatal("CreateProjectCtx succeeded, want the scripted failure")
+			}
+			if n := countRequests(s, "POST", "/v11/projects"); n != want {
+				t.Errorf("got %d requests, want %d", n, want)
+			}
+		})
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	s := vercelgotest.NewServer()
+	defer s.Close()
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	s.Fail(vercelgotest.Failure{Method: "GET", Status: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {"60"}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	c := s.Client(vercelgo.WithRetryPolicy(retryPolicy(0)))
+	start := time.Now()
+	_, err := c.GetTeamCtx(ctx, team.ID)
+	var apiErr *vercelgo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("GetTeamCtx error = %v, want the 503 of the last attempt", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %v, want the backoff to stop with ctx", elapsed)
+	}
+	if n := countRequests(s, "GET", "/v1/teams/"+team.ID); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
@@ -1,37 +1,129 @@
 package schemas
 
-import "time"
-
 type DeploymentFile struct {
 	File string `json:"file"`
 	Sha  string `json:"sha"`
 }
 
+// CreateDeploymentRequest is the body of a v13 deployment creation request.
 type CreateDeploymentRequest struct {
 	Name    string           `json:"name"`
-	Project string           `json:"project"`
-	Files   []DeploymentFile `json:"files"`
-	Target  string           `json:"target"`
+	Project string           `json:"project,omitempty"`
+	Files   []DeploymentFile `json:"files,omitempty"`
+	Target  string           `json:"target,omitempty"`
+
+	// GitSource builds the deployment from a Git commit instead of Files.
+	GitSource *GitSource `json:"gitSource,omitempty"`
+	// Env are the environment variables of the deployment at runtime,
+	// Build.Env the ones only available while building it.
+	Env   map[string]string `json:"env,omitempty"`
+	Build *DeploymentBuild  `json:"build,omitempty"`
+	// Meta are key/value tags attached to the deployment, e.g. a commit SHA.
+	Meta map[string]string `json:"meta,omitempty"`
+	// Regions are the regions where the functions of the deployment run, e.g. "iad1".
+	Regions []string `json:"regions,omitempty"`
+	// Public exposes the source and logs of the deployment.
+	Public *bool `json:"public,omitempty"`
+	// ProjectSettings overrides the settings of the project for this deployment.
+	ProjectSettings *ProjectSettings `json:"projectSettings,omitempty"`
+	// CustomEnvironmentSlugOrId deploys to a custom environment instead of Target.
+	CustomEnvironmentSlugOrId string `json:"customEnvironmentSlugOrId,omitempty"`
+}
+
+type GitSourceType string
+
+const (
+	GitSourceGitHub    GitSourceType = "github"
+	GitSourceGitLab    GitSourceType = "gitlab"
+	GitSourceBitbucket GitSourceType = "bitbucket"
+)
+
+// GitSource is the Git commit a deployment is built from. The repository is identified
+// by RepoId on GitHub, ProjectId on GitLab and WorkspaceUuid and RepoUuid on Bitbucket;
+// Ref is the branch or tag and Sha pins a commit of it.
+type GitSource struct {
+	Type          GitSourceType `json:"type"`
+	RepoId        string        `json:"repoId,omitempty"`
+	ProjectId     string        `json:"projectId,omitempty"`
+	WorkspaceUuid string        `json:"workspaceUuid,omitempty"`
+	RepoUuid      string        `json:"repoUuid,omitempty"`
+	Ref           string        `json:"ref"`
+	Sha           string        `json:"sha,omitempty"`
+}
+
+type DeploymentBuild struct {
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// ProjectSettings are the project settings that can be overridden by a deployment.
+type ProjectSettings struct {
+	Framework                       string  `json:"framework,omitempty"`
+	BuildCommand                    *string `json:"buildCommand,omitempty"`
+	DevCommand                      *string `json:"devCommand,omitempty"`
+	InstallCommand                  *string `json:"installCommand,omitempty"`
+	OutputDirectory                 *string `json:"outputDirectory,omitempty"`
+	RootDirectory                   *string `json:"rootDirectory,omitempty"`
+	NodeVersion                     string  `json:"nodeVersion,omitempty"`
+	ServerlessFunctionRegion        string  `json:"serverlessFunctionRegion,omitempty"`
+	CommandForIgnoringBuildStep     *string `json:"commandForIgnoringBuildStep,omitempty"`
+	SourceFilesOutsideRootDirectory *bool   `json:"sourceFilesOutsideRootDirectory,omitempty"`
+	SkipGitConnectDuringLink        *bool   `json:"skipGitConnectDuringLink,omitempty"`
+}
+
+// ReadyState is the lifecycle state of a deployment. A deployment normally goes through
+//
+//	QUEUED -> INITIALIZING -> BUILDING -> READY
+//
+// and may end in ERROR when it fails to initialize or build, or in CANCELED when it is canceled
+// before being ready. READY, ERROR and CANCELED are final. States unknown to this package,
+// which Vercel may introduce, are decoded as is and are not final.
+type ReadyState string
+
+const (
+	ReadyStateQueued       ReadyState = "QUEUED"
+	ReadyStateInitializing ReadyState = "INITIALIZING"
+	ReadyStateBuilding     ReadyState = "BUILDING"
+	ReadyStateReady        ReadyState = "READY"
+	ReadyStateError        ReadyState = "ERROR"
+	ReadyStateCanceled     ReadyState = "CANCELED"
+)
+
+// IsTerminal reports whether s is a final state, after which the deployment no longer changes.
+func (s ReadyState) IsTerminal() bool {
+	return s == ReadyStateReady || s == ReadyStateError || s == ReadyStateCanceled
+}
+
+// IsSuccess reports whether s is READY, the state of a deployment that can serve traffic.
+func (s ReadyState) IsSuccess() bool {
+	return s == ReadyStateReady
 }
 
 type DeploymentResponse struct {
-	Id         string           `json:"id"`
-	Uid        string           `json:"uid"`
-	Url        string           `json:"url"`
-	Files      []DeploymentFile `json:"files"`
-	Status     string           `json:"status"`
-	ReadyState string           `json:"readyState"`
-	Target     string           `json:"target"`
-	CreatedAt  int64            `json:"createdAt"`
+	Id           string            `json:"id"`
+	Uid          string            `json:"uid"`
+	Url          string            `json:"url"`
+	InspectorUrl string            `json:"inspectorUrl,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	Meta         map[string]string `json:"meta,omitempty"`
+	GitSource    *GitSource        `json:"gitSource,omitempty"`
+	Files        []DeploymentFile  `json:"files"`
+	Status       ReadyState        `json:"status"`
+	ReadyState   ReadyState        `json:"readyState"`
+	Target       string            `json:"target"`
+	CreatedAt    int64             `json:"createdAt"`
 }
 
 type DeploymentStatus struct {
-	Id         string `json:"id"`
-	Name       string `json:"name"`
-	Url        string `json:"url"`
-	Status     string `json:"status"`
-	ReadyState string `json:"readyState"`
-	CreatedAt  int64  `json:"createdAt"`
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Url        string     `json:"url"`
+	Status     ReadyState `json:"status"`
+	ReadyState ReadyState `json:"readyState"`
+	CreatedAt  int64      `json:"createdAt"`
+	// ErrorCode, ErrorMessage and ErrorStep describe why a deployment ended in the ERROR state.
+	ErrorCode    string `json:"errorCode,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	ErrorStep    string `json:"errorStep,omitempty"`
 }
 
 type DeploymentListResponse struct {
@@ -45,40 +137,61 @@ type CurrentDeploymentResponse struct {
 }
 
 type CurrentDeployment struct {
-	CreatedAt              int64   `json:"createdAt"`
-	DeploymentHostname     string  `json:"deploymentHostname"`
-	Id                     string  `json:"id"`
-	Name                   string  `json:"name"`
-	ReadyState             string  `json:"readyState"`
-	ReadySubstate          string  `json:"readySubstate"`
-	Source                 string  `json:"source"`
-	TeamId                 string  `json:"teamId"`
-	Url                    string  `json:"url"`
-	UserId                 string  `json:"userId"`
-	ProjectId              string  `json:"projectId"`
-	Target                 string  `json:"target"`
-	AliasError             *string `json:"aliasError"`
-	AliasAssignedAt        int64   `json:"aliasAssignedAt"`
-	AliasAssigned          int64   `json:"aliasAssigned"`
-	ReadyStateAt           int64   `json:"readyStateAt"`
-	BuildingAt             int64   `json:"buildingAt"`
-	PreviewCommentsEnabled bool    `json:"previewCommentsEnabled"`
-}
-
-
-type DeploymentLogType string;
-
-const(
-	Command DeploymentLogType ="command"
-	Stdout DeploymentLogType ="stdout"
-	StdErr DeploymentLogType ="stderr"
-	Exit DeploymentLogType ="exit"
+	CreatedAt              int64      `json:"createdAt"`
+	DeploymentHostname     string     `json:"deploymentHostname"`
+	Id                     string     `json:"id"`
+	Name                   string     `json:"name"`
+	ReadyState             ReadyState `json:"readyState"`
+	ReadySubstate          string     `json:"readySubstate"`
+	Source                 string     `json:"source"`
+	TeamId                 string     `json:"teamId"`
+	Url                    string     `json:"url"`
+	UserId                 string     `json:"userId"`
+	ProjectId              string     `json:"projectId"`
+	Target                 string     `json:"target"`
+	AliasError             *string    `json:"aliasError"`
+	AliasAssignedAt        int64      `json:"aliasAssignedAt"`
+	AliasAssigned          int64      `json:"aliasAssigned"`
+	ReadyStateAt           int64      `json:"readyStateAt"`
+	BuildingAt             int64      `json:"buildingAt"`
+	PreviewCommentsEnabled bool       `json:"previewCommentsEnabled"`
+}
+
+type DeploymentLogType string
+
+const (
+	Command DeploymentLogType = "command"
+	Stdout  DeploymentLogType = "stdout"
+	StdErr  DeploymentLogType = "stderr"
+	Exit    DeploymentLogType = "exit"
 )
 
-type DeployLogsResponse struct{
+// DeployLogsResponse is a build event of a deployment. Created is in milliseconds since the epoch.
+type DeployLogsResponse struct {
 	Type    DeploymentLogType `json:"type"`
-	Created time.Time         `json:"created"`
-	Payload map[string]any    `json:"payload"`
+	Created int64             `json:"created"`
+	Payload DeployLogPayload  `json:"payload"`
+}
+
+type DeployLogPayload struct {
+	Id           string `json:"id,omitempty"`
+	DeploymentId string `json:"deploymentId"`
+	Text         string `json:"text"`
+	Date         int64  `json:"date"`
+	StatusCode   int    `json:"statusCode,omitempty"`
+}
+
+// DeploymentLogsOptions selects the build events of a deployment.
+type DeploymentLogsOptions struct {
+	// Since and Until bound the creation date of the events, in milliseconds since the epoch.
+	Since int64 `json:"since,omitempty"`
+	Until int64 `json:"until,omitempty"`
+	// Limit caps the number of events returned; zero returns every event.
+	Limit int `json:"limit,omitempty"`
+	// Direction is "forward" to return the oldest events first, the default, or "backward".
+	Direction string `json:"direction,omitempty"`
+	// Types keeps the events of the given types only; empty keeps every event.
+	Types []DeploymentLogType `json:"types,omitempty"`
 }
 
 type CurrentDomain struct {
@@ -0,0 +1,43 @@
+package schemas
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestReadyState(t *testing.T) {
+	tests := []struct {
+		state               ReadyState
+		terminal, succeeded bool
+	}{
+		{ReadyStateQueued, false, false},
+		{ReadyStateInitializing, false, false},
+		{ReadyStateBuilding, false, false},
+		{ReadyStateReady, true, true},
+		{ReadyStateError, true, false},
+		{ReadyStateCanceled, true, false},
+		{"", false, false},
+		{"PAUSED", false, false},
+	}
+	for _, tt := range tests {
+		if got := tt.state.IsTerminal(); got != tt.terminal {
+			t.Errorf("ReadyState(%q).IsTerminal() = %v, want %v", tt.state, got, tt.terminal)
+		}
+		if got := tt.state.IsSuccess(); got != tt.succeeded {
+			t.Errorf("ReadyState(%q).IsSuccess() = %v, want %v", tt.state, got, tt.succeeded)
+		}
+	}
+}
+
+func TestUnknownReadyStateDecodes(t *testing.T) {
+	var status DeploymentStatus
+	if err := json.Unmarshal([]byte(`{"id":"dpl_1","status":"PAUSED","readyState":"PAUSED"}`), &status); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if status.ReadyState != "PAUSED" || status.Status != "PAUSED" {
+		t.Errorf("decoded states %q and %q, want PAUSED", status.ReadyState, status.Status)
+	}
+	if status.ReadyState.IsTerminal() {
+		t.Error("unknown ready state is terminal, want waiting to go on")
+	}
+}
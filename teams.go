@@ -1,28 +1,31 @@
 package vercelgo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
+	"net/url"
+	"strconv"
 
-	"github.com/GitDocAI/vercelgo/config"
 	"github.com/GitDocAI/vercelgo/schemas"
-	"github.com/GitDocAI/vercelgo/utils"
 )
 
 // Get information for the Team specified by the teamId parameter.
 func (c *VercelClient) GetTeam(teamId string) (*schemas.Team, error) {
-	response, statusCode, err := utils.DoReq[schemas.Team](
-		fmt.Sprintf("%s/v1/teams/%s", config.BaseURL, teamId),
-		nil, "GET", c.GetHeaders(), false, time.Second*10,
-	)
+	return c.GetTeamCtx(context.Background(), teamId)
+}
+
+// GetTeamCtx is like GetTeam but uses ctx for every request it makes.
+func (c *VercelClient) GetTeamCtx(ctx context.Context, teamId string) (*schemas.Team, error) {
+	response, statusCode, err := doRequest[schemas.Team](ctx, c, "GetTeam", "GET", c.url(nil, "/v1/teams/%s", c.team(teamId)), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get team: %s", response)
+		return nil, fmt.Errorf("failed to get team: status %d", statusCode)
 	}
 
 	return &response, nil
@@ -30,26 +33,58 @@ func (c *VercelClient) GetTeam(teamId string) (*schemas.Team, error) {
 
 // Get a paginated list of all the Teams the authenticated User is a member of.
 func (c *VercelClient) ListTeams(filter *schemas.Filter) ([]schemas.Team, error) {
-	url := fmt.Sprintf("%s/v1/teams", config.BaseURL)
+	return c.ListTeamsCtx(context.Background(), filter)
+}
+
+// ListTeamsCtx is like ListTeams but uses ctx for every request it makes.
+func (c *VercelClient) ListTeamsCtx(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error) {
+	teams, _, err := c.listTeamsPage(ctx, filter, 0)
+	return teams, err
+}
+
+// IterTeams returns an iterator over all the Teams the authenticated User is a member of,
+// fetching further pages as needed. filter.Limit sets the page size.
+func (c *VercelClient) IterTeams(ctx context.Context, filter *schemas.Filter) iter.Seq2[schemas.Team, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor int64) ([]schemas.Team, schemas.Pagination, error) {
+		return c.listTeamsPage(ctx, filter, cursor)
+	})
+}
+
+// ListTeamsAll returns all the Teams the authenticated User is a member of, across every page.
+func (c *VercelClient) ListTeamsAll(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error) {
+	return Collect(c.IterTeams(ctx, filter))
+}
+
+// listTeamsPage fetches the page of teams created before until, or the first page when until is zero.
+func (c *VercelClient) listTeamsPage(ctx context.Context, filter *schemas.Filter, until int64) ([]schemas.Team, schemas.Pagination, error) {
+	query := url.Values{}
 	if filter != nil && filter.Limit > 0 {
-		url = fmt.Sprintf("%s?limit=%d", url, filter.Limit)
+		query.Set("limit", strconv.FormatInt(filter.Limit, 10))
+	}
+	if until != 0 {
+		query.Set("until", strconv.FormatInt(until, 10))
 	}
 
-	response, statusCode, err := utils.DoReq[schemas.ListTeamsResponse](url, nil, "GET", c.GetHeaders(), false, time.Second*10)
+	response, statusCode, err := doRequest[schemas.ListTeamsResponse](ctx, c, "ListTeams", "GET", c.url(query, "/v1/teams"), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list teams: %w", err)
+		return nil, schemas.Pagination{}, fmt.Errorf("failed to list teams: %w", err)
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to list teams: %v", err)
+		return nil, schemas.Pagination{}, fmt.Errorf("failed to list teams: status %d", statusCode)
 	}
 
-	return response.Teams, nil
+	return response.Teams, response.Pagination, nil
 }
 
 // Create a new Team under your account.
 // You need to send a POST request with the desired Team slug, and optionally the Team name.
 func (c *VercelClient) CreateTeam(slug, name string) (string, error) {
+	return c.CreateTeamCtx(context.Background(), slug, name)
+}
+
+// CreateTeamCtx is like CreateTeam but uses ctx for every request it makes.
+func (c *VercelClient) CreateTeamCtx(ctx context.Context, slug, name string) (string, error) {
 	team := schemas.Team{
 		Name: name,
 		Slug: slug,
@@ -60,21 +95,26 @@ func (c *VercelClient) CreateTeam(slug, name string) (string, error) {
 		return "", fmt.Errorf("failed to marshal team: %w", err)
 	}
 
-	response, statusCode, err := utils.DoReq[schemas.Team](fmt.Sprintf("%s/v1/teams", config.BaseURL), body, "POST", c.GetHeaders(), false, time.Second*10)
+	response, statusCode, err := doRequest[schemas.Team](ctx, c, "CreateTeam", "POST", c.url(nil, "/v1/teams"), body)
 	if err != nil {
 		return "", fmt.Errorf("failed to create team: %w", err)
 	}
 
 	if statusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to create team: %s", response)
+		return "", fmt.Errorf("failed to create team: status %d", statusCode)
 	}
 
-	return team.ID, nil
+	return response.ID, nil
 }
 
 // Update the information of a Team specified by the teamId parameter.
 // The name and slug parameters are optional.
 func (c *VercelClient) UpdateTeam(teamId, name, slug string) (*schemas.Team, error) {
+	return c.UpdateTeamCtx(context.Background(), teamId, name, slug)
+}
+
+// UpdateTeamCtx is like UpdateTeam but uses ctx for every request it makes.
+func (c *VercelClient) UpdateTeamCtx(ctx context.Context, teamId, name, slug string) (*schemas.Team, error) {
 	team := schemas.Team{}
 	if name != "" {
 		team.Name = name
@@ -88,16 +128,13 @@ func (c *VercelClient) UpdateTeam(teamId, name, slug string) (*schemas.Team, err
 		return nil, fmt.Errorf("failed to marshal team: %w", err)
 	}
 
-	response, statusCode, err := utils.DoReq[schemas.Team](
-		fmt.Sprintf("%s/v1/teams/%s", config.BaseURL, teamId),
-		body, "PATCH", c.GetHeaders(), false, time.Second*10,
-	)
+	response, statusCode, err := doRequest[schemas.Team](ctx, c, "UpdateTeam", "PATCH", c.url(nil, "/v1/teams/%s", c.team(teamId)), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update team: %w", err)
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update team: %s", response)
+		return nil, fmt.Errorf("failed to update team: status %d", statusCode)
 	}
 
 	return &response, nil
@@ -107,20 +144,22 @@ func (c *VercelClient) UpdateTeam(teamId, name, slug string) (*schemas.Team, err
 // You need to send teamId as required parameter.
 // An optional array of reasons for deletion may also be sent.
 func (c *VercelClient) DeleteTeam(teamId string, reasons []schemas.Reason) error {
+	return c.DeleteTeamCtx(context.Background(), teamId, reasons)
+}
+
+// DeleteTeamCtx is like DeleteTeam but uses ctx for every request it makes.
+func (c *VercelClient) DeleteTeamCtx(ctx context.Context, teamId string, reasons []schemas.Reason) error {
 	body, err := json.Marshal(schemas.DeleteTeamRequest{Reasons: reasons})
 	if err != nil {
 		return fmt.Errorf("failed to marshal delete team request: %w", err)
 	}
-	_, statusCode, err := utils.DoReq[map[string]interface{}](
-		fmt.Sprintf("%s/v1/teams/%s", config.BaseURL, teamId),
-		body, "DELETE", c.GetHeaders(), false, time.Second*10,
-	)
+	_, statusCode, err := doRequest[map[string]interface{}](ctx, c, "DeleteTeam", "DELETE", c.url(nil, "/v1/teams/%s", c.team(teamId)), body)
 	if err != nil {
-		return fmt.Errorf("failed to delete team: %v", err)
+		return fmt.Errorf("failed to delete team: %w", err)
 	}
 
 	if statusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to delete team: %v", err)
+		return fmt.Errorf("failed to delete team: status %d", statusCode)
 	}
 
 	return nil
@@ -0,0 +1,352 @@
+package vercelgo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/utils"
+)
+
+// deployFile is a file of a deployment along with the way to read its content.
+// Content is always streamed from open, so files are never held in memory as a whole.
+type deployFile struct {
+	schemas.DeploymentFile
+	size int64
+	open func() (io.ReadCloser, error)
+}
+
+// collectFiles walks fsys and returns the files to deploy, named by their slash-separated path in fsys.
+// Files are filtered by the default patterns, the ignore files found at the root of fsys
+// and the exclude and include patterns of cfg, in that order of precedence.
+func collectFiles(fsys fs.FS, cfg *deployConfig) ([]deployFile, error) {
+	matcher, err := cfg.matcher(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []deployFile
+	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("error accessing path %q: %w", path, err)
+		}
+		if path == "." {
+			return nil
+		}
+
+		if matcher.Match(path, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("error accessing path %q: %w", path, err)
+		}
+		files = append(files, deployFile{
+			DeploymentFile: schemas.DeploymentFile{File: path},
+			size:           info.Size(),
+			open:           func() (io.ReadCloser, error) { return fsys.Open(path) },
+		})
+		return nil
+	})
+	return files, err
+}
+
+// matcher builds the ignore matcher of a deployment of fsys.
+func (cfg *deployConfig) matcher(fsys fs.FS) (*utils.IgnoreMatcher, error) {
+	matcher := utils.NewIgnoreMatcher(cfg.defaultIgnores...)
+	for _, name := range cfg.ignoreFiles {
+		f, err := fsys.Open(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error opening ignore file %q: %w", name, err)
+		}
+		err = matcher.AddFrom(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading ignore file %q: %w", name, err)
+		}
+	}
+	matcher.Add(cfg.exclude...)
+	matcher.Add(cfg.include...)
+	return matcher, nil
+}
+
+// listedFiles converts the files given to DeployFiles, checking that their names are valid and unique.
+func listedFiles(files []DeployFile) ([]deployFile, error) {
+	listed := make([]deployFile, 0, len(files))
+	seen := make(map[string]bool, len(files))
+	for _, file := range files {
+		if !fs.ValidPath(file.Name) || file.Name == "." {
+			return nil, fmt.Errorf("invalid file name %q", file.Name)
+		}
+		if seen[file.Name] {
+			return nil, fmt.Errorf("duplicate file name %q", file.Name)
+		}
+		seen[file.Name] = true
+
+		// The size of a file read with Open is only known once it is hashed.
+		var size int64
+		open := file.Open
+		if open == nil {
+			data := file.Data
+			size = int64(len(data))
+			open = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }
+		}
+		listed = append(listed, deployFile{
+			DeploymentFile: schemas.DeploymentFile{File: file.Name},
+			size:           size,
+			open:           open,
+		})
+	}
+	return listed, nil
+}
+
+// hashFile computes the SHA-1 digest and the size of the content of file.
+func hashFile(file deployFile) (string, int64, error) {
+	r, err := file.open()
+	if err != nil {
+		return "", 0, err
+	}
+	defer r.Close()
+
+	hash := sha1.New()
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// hashFiles computes the SHA-1 digest and the size of every file, reading at most workers files at once.
+// The size read replaces the one known when the file was listed.
+func hashFiles(ctx context.Context, workers int, files []deployFile, progress *progress) error {
+	return forEach(ctx, workers, len(files), func(ctx context.Context, i int) error {
+		sha, size, err := hashFile(files[i])
+		if err != nil {
+			return fmt.Errorf("error reading file %q: %w", files[i].File, err)
+		}
+
+		listedSize := files[i].size
+		files[i].Sha = sha
+		files[i].size = size
+		progress.report(ProgressFileHashed, &files[i], func(totals *DeployProgress) {
+			totals.HashedFiles++
+			totals.HashedBytes += size
+			totals.TotalBytes += size - listedSize
+		})
+		return nil
+	})
+}
+
+// uploadFiles uploads the files whose SHA is listed in shas, or every file when shas is nil.
+// Files sharing a SHA are uploaded once. At most c.uploadConcurrency uploads run at once
+// and the first failure cancels the remaining uploads.
+func (c *VercelClient) uploadFiles(ctx context.Context, teamId string, files []deployFile, shas []string, progress *progress) error {
+	wanted := make(map[string]bool, len(shas))
+	for _, sha := range shas {
+		wanted[sha] = true
+	}
+
+	var pending, skipped []deployFile
+	var pendingBytes int64
+	seen := map[string]bool{}
+	for _, file := range files {
+		if seen[file.Sha] || (shas != nil && !wanted[file.Sha]) {
+			skipped = append(skipped, file)
+			continue
+		}
+		seen[file.Sha] = true
+		pending = append(pending, file)
+		pendingBytes += file.size
+	}
+	c.settings().logger.DebugContext(ctx, "uploading deployment files", "files", len(pending), "skipped", len(skipped))
+
+	progress.set(func(totals *DeployProgress) {
+		totals.UploadTotalFiles = len(pending)
+		totals.UploadTotalBytes = pendingBytes
+	})
+	for i := range skipped {
+		progress.report(ProgressUploadSkipped, &skipped[i], func(totals *DeployProgress) {
+			totals.SkippedFiles++
+		})
+	}
+
+	return forEach(ctx, c.settings().uploadConcurrency, len(pending), func(ctx context.Context, i int) error {
+		file := pending[i]
+
+		progress.report(ProgressUploadStarted, &file, nil)
+		start := time.Now()
+		if err := c.uploadFile(ctx, teamId, file); err != nil {
+			return fmt.Errorf("error uploading file %q: %w", file.File, err)
+		}
+		c.settings().logger.DebugContext(ctx, "uploaded deployment file", "file", file.File, "sha", file.Sha, "size", file.size, "duration", time.Since(start))
+		progress.report(ProgressUploadFinished, &file, func(totals *DeployProgress) {
+			totals.UploadedFiles++
+			totals.UploadedBytes += file.size
+		})
+		return nil
+	})
+}
+
+// uploadFile uploads the content of a single hashed deployment file, streaming it from file.open
+// with the size found while hashing as Content-Length. Uploads are content-addressed,
+// so they are always retried according to the client retry policy, reopening the file every time.
+// Since large files take long to send, an upload is only aborted once it stalls for c.uploadTimeout.
+func (c *VercelClient) uploadFile(ctx context.Context, teamId string, file deployFile) error {
+	return c.retry(ctx, true, func(ctx context.Context) error {
+		ctx, stalled := newStallTimer(ctx, c.settings().uploadTimeout)
+		defer stalled.stop()
+
+		content, err := file.open()
+		if err != nil {
+			return fmt.Errorf("error opening file: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, "POST", c.url(teamQuery(teamId), "/v2/files"), stalled.reader(content))
+		if err != nil {
+			content.Close()
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		req.ContentLength = file.size
+		req.GetBody = func() (io.ReadCloser, error) {
+			content, err := file.open()
+			if err != nil {
+				return nil, err
+			}
+			return stalled.reader(content), nil
+		}
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+		req.Header.Set("x-vercel-digest", file.Sha)
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("User-Agent", c.settings().userAgent)
+
+		res, err := c.doer("UploadFile").Do(req)
+		if err != nil {
+			return stalled.wrap(err)
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return stalled.wrap(err)
+		}
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return newAPIError(req.Method, req.URL.String(), res.StatusCode, res.Header, body)
+		}
+		return nil
+	})
+}
+
+// stallTimer cancels the context of an upload once no byte of its body was read for timeout,
+// which also bounds the wait for the response after the last byte.
+type stallTimer struct {
+	ctx     context.Context
+	cancel  context.CancelCauseFunc
+	timer   *time.Timer
+	timeout time.Duration
+	err     error
+}
+
+// newStallTimer returns a context cancelled once the upload stalls for timeout, or never when timeout is zero.
+func newStallTimer(ctx context.Context, timeout time.Duration) (context.Context, *stallTimer) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	t := &stallTimer{
+		ctx:     ctx,
+		cancel:  cancel,
+		timeout: timeout,
+		err:     fmt.Errorf("upload stalled for %v: %w", timeout, context.DeadlineExceeded),
+	}
+	if timeout > 0 {
+		t.timer = time.AfterFunc(timeout, func() { cancel(t.err) })
+	}
+	return ctx, t
+}
+
+// reader returns r, restarting the timer whenever data is read from it.
+func (t *stallTimer) reader(r io.ReadCloser) io.ReadCloser {
+	if t.timer == nil {
+		return r
+	}
+	return &stallReader{ReadCloser: r, timer: t}
+}
+
+// wrap reports the stall along with err when it caused the failure. err is kept in the chain
+// so that the failed attempt is still retried as a network error.
+func (t *stallTimer) wrap(err error) error {
+	if context.Cause(t.ctx) == t.err {
+		return fmt.Errorf("%w: %w", t.err, err)
+	}
+	return err
+}
+
+// stop releases the timer and the upload context.
+func (t *stallTimer) stop() {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.cancel(nil)
+}
+
+type stallReader struct {
+	io.ReadCloser
+	timer *stallTimer
+}
+
+func (r *stallReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.timer.timer.Reset(r.timer.timeout)
+	}
+	return n, err
+}
+
+// forEach calls fn for every index in [0, n) from at most workers goroutines.
+// The first error cancels the context passed to the other calls and is returned once all calls have finished.
+func forEach(ctx context.Context, workers, n int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(workers, 1), n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := fn(ctx, i); err != nil {
+					cancel(err)
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range n {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	return context.Cause(ctx)
+}
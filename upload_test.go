@@ -0,0 +1,268 @@
+package vercelgo_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+	"github.com/GitDocAI/vercelgo/vercelgotest"
+)
+
+// newProject returns a server holding a single project for deployments.
+func newProject(t testing.TB) (*vercelgotest.Server, schemas.Team, schemas.Project) {
+	t.Helper()
+	s := vercelgotest.NewServer()
+	t.Cleanup(s.Close)
+	team := s.AddTeam(schemas.Team{Slug: "acme"})
+	project := s.AddProject(team.ID, schemas.Project{Name: "site"})
+	return s, team, project
+}
+
+// createdDeployments decodes the deployment creation requests received by s.
+func createdDeployments(t testing.TB, s *vercelgotest.Server) []schemas.CreateDeploymentRequest {
+	t.Helper()
+	var created []schemas.CreateDeploymentRequest
+	for _, r := range s.Requests() {
+		if r.Method != "POST" || r.Path != "/v13/deployments" {
+			continue
+		}
+		var req schemas.CreateDeploymentRequest
+		if err := json.Unmarshal(r.Body, &req); err != nil {
+			t.Fatalf("decoding deployment creation body: %v", err)
+		}
+		created = append(created, req)
+	}
+	return created
+}
+
+func TestDeployFilesCancelsUploadsOnFailure(t *testing.T) {
+	s, team, project := newProject(t)
+	const concurrency = 2
+	c := s.Client(vercelgo.WithUploadConcurrency(concurrency))
+	s.Fail(vercelgotest.Failure{Method: "POST", Path: "/v2/files", Status: http.StatusBadRequest, Code: "bad_request"})
+
+	var files []vercelgo.DeployFile
+	for i := range 50 {
+		files = append(files, vercelgo.DeployFile{Name: fmt.Sprintf("file%02d.txt", i), Data: []byte(strconv.Itoa(i))})
+	}
+	_, err := c.DeployFiles(context.Background(), project.ID, "site", files, team.ID, "")
+	var apiErr *vercelgo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("DeployFiles error = %v, want the scripted upload failure", err)
+	}
+
+	// Uploads already sent when the failure arrives may complete, but no further upload starts.
+	if n := countRequests(s, "POST", "/v2/files"); n > 2*concurrency {
+		t.Errorf("got %d uploads after the first failure, want at most %d", n, 2*concurrency)
+	}
+	if n := countRequests(s, "POST", "/v13/deployments"); n != 1 {
+		t.Errorf("got %d deployment creations, want only the one reporting missing files", n)
+	}
+}
+
+func TestDeployFSFilesOrder(t *testing.T) {
+	fsys := fstest.MapFS{}
+	for i := range 100 {
+		fsys[fmt.Sprintf("dir%d/file%d.txt", i%7, i)] = &fstest.MapFile{Data: []byte(strconv.Itoa(i))}
+	}
+	var want []string
+	fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if !d.IsDir() {
+			want = append(want, path)
+		}
+		return err
+	})
+
+	s, team, project := newProject(t)
+	c := s.Client(vercelgo.WithUploadConcurrency(16))
+	for range 3 {
+		if _, err := c.DeployFS(context.Background(), project.ID, "site", fsys, team.ID, ""); err != nil {
+			t.Fatalf("DeployFS: %v", err)
+		}
+	}
+
+	for i, req := range createdDeployments(t, s) {
+		var got []string
+		for _, file := range req.Files {
+			got = append(got, file.File)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("deployment creation %d lists files %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestDeployDirSparseFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("uploads a 300MB file")
+	}
+	const size = 300 << 20
+	dir := t.TempDir()
+	f, err := os.Create(filepath.Join(dir, "disk.img"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := f.Truncate(size); err != nil {
+		t.Fatal(err)
+	}
+	hash := sha1.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		t.Fatal(err)
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	s, team, project := newProject(t)
+	var result *vercelgo.DeployResult
+	peak := peakHeap(func() {
+		result, err = s.Client().DeployDir(context.Background(), project.ID, "site", dir, team.ID, "")
+	})
+	if err != nil {
+		t.Fatalf("DeployDir: %v", err)
+	}
+	if len(result.Deployment.Files) != 1 || result.Deployment.Files[0].Sha != digest {
+		t.Fatalf("deployed files %+v, want disk.img with SHA %s", result.Deployment.Files, digest)
+	}
+	if result.Bytes != size || result.UploadedBytes != size {
+		t.Errorf("deployed %d bytes and uploaded %d, want %d", result.Bytes, result.UploadedBytes, size)
+	}
+	// The fake server checks the x-vercel-digest header against the uploaded content.
+	if uploaded, ok := s.File(digest); !ok || uploaded != size {
+		t.Errorf("uploaded %d bytes for %s, want %d", uploaded, digest, size)
+	}
+	// Files are streamed, so memory is bounded by the buffers of the upload workers, not by the file size.
+	if limit := uint64(vercelgo.DefaultUploadConcurrency << 20); peak > limit {
+		t.Errorf("heap grew by %d bytes while deploying, want at most %d", peak, limit)
+	}
+}
+
+// peakHeap runs f and returns the largest growth of the heap seen while it ran.
+func peakHeap(f func()) uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	base, peak := stats.HeapAlloc, stats.HeapAlloc
+
+	done := make(chan struct{})
+	sampled := make(chan struct{})
+	go func() {
+		defer close(sampled)
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		var stats runtime.MemStats
+		for {
+			runtime.ReadMemStats(&stats)
+			peak = max(peak, stats.HeapAlloc)
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	f()
+	close(done)
+	<-sampled
+	return peak - base
+}
+
+// slowReader returns chunks of data, waiting delay before each of them.
+type slowReader struct {
+	chunks int
+	delay  time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if r.chunks == 0 {
+		return 0, io.EOF
+	}
+	time.Sleep(r.delay)
+	r.chunks--
+	return copy(p, "chunk"), nil
+}
+
+func (r *slowReader) Close() error { return nil }
+
+func TestUploadTimeoutAppliesToStalls(t *testing.T) {
+	tests := []struct {
+		name    string
+		reader  func() io.ReadCloser
+		wantErr bool
+	}{
+		{
+			name:   "slow but steady",
+			reader: func() io.ReadCloser { return &slowReader{chunks: 10, delay: 30 * time.Millisecond} },
+		},
+		{
+			name:    "stalled",
+			reader:  func() io.ReadCloser { return &slowReader{chunks: 1, delay: 300 * time.Millisecond} },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, team, project := newProject(t)
+			c := s.Client(vercelgo.WithUploadTimeout(100 * time.Millisecond))
+			files := []vercelgo.DeployFile{{
+				Name: "data.txt",
+				Open: func() (io.ReadCloser, error) { return tt.reader(), nil },
+			}}
+
+			_, err := c.DeployFiles(context.Background(), project.ID, "site", files, team.ID, "")
+			switch {
+			case tt.wantErr && !errors.Is(err, context.DeadlineExceeded):
+				t.Errorf("DeployFiles error = %v, want the upload to stall", err)
+			case !tt.wantErr && err != nil:
+				t.Errorf("DeployFiles: %v", err)
+			}
+		})
+	}
+}
+
+func BenchmarkDeployFiles(b *testing.B) {
+	const (
+		fileCount = 64
+		fileSize  = 64 << 10
+	)
+	for _, concurrency := range []int{1, 4, 16} {
+		b.Run("concurrency="+strconv.Itoa(concurrency), func(b *testing.B) {
+			s, team, project := newProject(b)
+			c := s.Client(vercelgo.WithUploadConcurrency(concurrency))
+			data := make([]byte, fileSize)
+
+			b.SetBytes(fileCount * fileSize)
+			for i := 0; b.Loop(); i++ {
+				// Every iteration deploys new content so that every file has to be uploaded.
+				files := make([]vercelgo.DeployFile, fileCount)
+				for j := range files {
+					content := slices.Clone(data)
+					copy(content, fmt.Sprintf("%d/%d", i, j))
+					files[j] = vercelgo.DeployFile{Name: fmt.Sprintf("file%d.bin", j), Data: content}
+				}
+				result, err := c.DeployFiles(context.Background(), project.ID, "site", files, team.ID, "")
+				if err != nil {
+					b.Fatalf("DeployFiles: %v", err)
+				}
+				if result.UploadedFiles != fileCount {
+					b.Fatalf("uploaded %d files, want %d", result.UploadedFiles, fileCount)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIgnoreMatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		path     string
+		isDir    bool
+		want     bool
+	}{
+		{"name matches at root", []string{"*.log"}, "debug.log", false, true},
+		{"name matches in subdirectory", []string{"*.log"}, "logs/debug.log", false, true},
+		{"name does not match", []string{"*.log"}, "debug.txt", false, false},
+		{"star stays within a segment", []string{"docs/*.md"}, "docs/guide/intro.md", false, false},
+		{"question mark matches one character", []string{"?.txt"}, "a.txt", false, true},
+		{"question mark matches exactly one character", []string{"?.txt"}, "ab.txt", false, false},
+
+		{"leading slash anchors to root", []string{"/build"}, "build", true, true},
+		{"leading slash does not match deeper", []string{"/build"}, "src/build", true, false},
+		{"middle slash anchors to root", []string{"docs/*.md"}, "docs/intro.md", false, true},
+		{"middle slash does not match deeper", []string{"docs/*.md"}, "site/docs/intro.md", false, false},
+
+		{"trailing slash matches directory", []string{"build/"}, "build", true, true},
+		{"trailing slash matches nested directory", []string{"build/"}, "src/build", true, true},
+		{"trailing slash skips file", []string{"build/"}, "build", false, false},
+
+		{"leading double star matches at root", []string{"**/temp"}, "temp", false, true},
+		{"leading double star matches deeper", []string{"**/temp"}, "a/b/temp", false, true},
+		{"middle double star matches no directory", []string{"a/**/b"}, "a/b", false, true},
+		{"middle double star matches directories", []string{"a/**/b"}, "a/x/y/b", false, true},
+		{"trailing double star matches contents", []string{"logs/**"}, "logs/a/b.txt", false, true},
+		{"trailing double star skips the directory itself", []string{"logs/**"}, "logs", true, false},
+
+		{"character class matches", []string{"*.[oa]"}, "lib.a", false, true},
+		{"character class does not match", []string{"*.[oa]"}, "lib.c", false, false},
+		{"negated character class matches", []string{"[!a]bc"}, "xbc", false, true},
+		{"negated character class does not match", []string{"[!a]bc"}, "abc", false, false},
+		{"character range", []string{"file[0-9]"}, "file7", false, true},
+		{"unterminated class is literal", []string{"file["}, "file[", false, true},
+
+		{"negation re-includes", []string{"*.log", "!keep.log"}, "keep.log", false, false},
+		{"negation leaves others ignored", []string{"*.log", "!keep.log"}, "debug.log", false, true},
+		{"last matching pattern wins", []string{"!keep.log", "*.log"}, "keep.log", false, true},
+		{"negation of dot directory", []string{".*", "!.well-known"}, ".well-known", true, false},
+
+		{"comment is ignored", []string{"#notes"}, "#notes", false, false},
+		{"escaped hash is literal", []string{`\#notes`}, "#notes", false, true},
+		{"escaped bang is literal", []string{`\!important`}, "!important", false, true},
+		{"escaped bang does not negate", []string{"*", `\!important`}, "!important", false, true},
+		{"escaped star is literal", []string{`\*.txt`}, "*.txt", false, true},
+		{"escaped star matches nothing else", []string{`\*.txt`}, "a.txt", false, false},
+
+		{"trailing spaces are trimmed", []string{"notes.txt  "}, "notes.txt", false, true},
+		{"escaped trailing space is kept", []string{`notes\ `}, "notes ", false, true},
+		{"escaped trailing space is required", []string{`notes\ `}, "notes", false, false},
+		{"carriage return is trimmed", []string{"*.tmp\r"}, "a.tmp", false, true},
+		{"blank pattern matches nothing", []string{"", "   "}, "a", false, false},
+		{"regexp characters are literal", []string{"a+b(c).txt"}, "a+b(c).txt", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewIgnoreMatcher(tt.patterns...)
+			if got := m.Match(tt.path, tt.isDir); got != tt.want {
+				t.Errorf("Match(%q, %v) with %q = %v, want %v", tt.path, tt.isDir, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreMatcherAddFrom(t *testing.T) {
+	m := NewIgnoreMatcher()
+	err := m.AddFrom(strings.NewReader("# build output\r\ndist/\r\n\r\n*.log\r\n!keep.log\r\n"))
+	if err != nil {
+		t.Fatalf("AddFrom: %v", err)
+	}
+
+	for path, want := range map[string]bool{
+		"dist":      true,
+		"debug.log": true,
+		"keep.log":  false,
+		"index.js":  false,
+	} {
+		if got := m.Match(path, path == "dist"); got != want {
+			t.Errorf("Match(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
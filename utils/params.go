@@ -44,6 +44,11 @@ func BuildQueryParams(filter interface{}) string {
 }
 
 func BuildProjectDomainsParams(teamId string, opts *schemas.Options) string {
+	return ProjectDomainsValues(teamId, opts).Encode()
+}
+
+// ProjectDomainsValues returns the query parameters of a project domains request.
+func ProjectDomainsValues(teamId string, opts *schemas.Options) url.Values {
 	values := url.Values{}
 	values.Add("teamId", teamId)
 
@@ -86,5 +91,5 @@ func BuildProjectDomainsParams(teamId string, opts *schemas.Options) string {
 		}
 	}
 
-	return values.Encode()
+	return values
 }
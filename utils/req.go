@@ -2,19 +2,36 @@ package utils
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
-	"time"
 )
 
-func DoReq[response any](url string, data []byte, method string, headers map[string]string, skipTlsVerification bool, timeout time.Duration) (response, int, error) {
+// Doer sends HTTP requests. *http.Client implements it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// StatusError is returned by DoReq when the server answers with a non-2xx status code.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("while sending request to %s received status code: %d and response body: %s", e.URL, e.StatusCode, e.Body)
+}
+
+func DoReq[response any](ctx context.Context, client Doer, url string, data []byte, method string, headers map[string]string) (response, int, error) {
 	var result response
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(data))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(data))
 	if err != nil {
 		return result, http.StatusInternalServerError, err
 	}
@@ -23,13 +40,6 @@ func DoReq[response any](url string, data []byte, method string, headers map[str
 		req.Header.Add(k, v)
 	}
 
-	client := &http.Client{
-		Timeout: timeout,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: skipTlsVerification},
-		},
-	}
-
 	resp, err := client.Do(req)
 	if err != nil {
 		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
@@ -44,12 +54,18 @@ func DoReq[response any](url string, data []byte, method string, headers map[str
 		return result, http.StatusInternalServerError, err
 	}
 
-	if len(body) == 0 || string(body) == `""` {
-		return result, resp.StatusCode, nil
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return result, resp.StatusCode, &StatusError{
+			Method:     method,
+			URL:        url,
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       body,
+		}
 	}
 
-	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
-		return result, resp.StatusCode, fmt.Errorf("while sending request to %s received status code: %d and response body: %s", url, resp.StatusCode, body)
+	if len(body) == 0 || string(body) == `""` {
+		return result, resp.StatusCode, nil
 	}
 
 	err = json.Unmarshal(body, &result)
@@ -1,28 +1,170 @@
 package vercelgo
 
-import "sync"
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/config"
+)
+
+const (
+	// DefaultTimeout is the per-request timeout used for API calls when none is configured.
+	DefaultTimeout = 30 * time.Second
+	// DefaultUploadTimeout is how long a deployment file upload may stall when none is configured.
+	DefaultUploadTimeout = 15 * time.Second
+	// DefaultUploadConcurrency is the number of deployment files uploaded at once when none is configured.
+	DefaultUploadConcurrency = 8
+	// DefaultUserAgent is the User-Agent sent with every request when none is configured.
+	DefaultUserAgent = "vercelgo"
+)
 
 var (
 	vercelClient     *VercelClient
 	vercelClientOnce sync.Once
+
+	// defaultClient holds the settings of clients created as struct literals rather than with NewClient,
+	// so that they all share one pooled HTTP client.
+	defaultClient = sync.OnceValue(func() *VercelClient { return NewClient() })
 )
 
+// VercelClient is a client for the Vercel REST API.
+// Every client holds its own settings, so several clients with different tokens can be used side by side.
+// A client created as a struct literal, such as &VercelClient{Token: token}, uses the defaults of NewClient.
 type VercelClient struct {
 	Token string
+
+	baseURL           string
+	teamID            string
+	userAgent         string
+	httpClient        *http.Client
+	timeout           time.Duration
+	uploadTimeout     time.Duration
+	retryPolicy       RetryPolicy
+	beforeRequest     []BeforeRequestHook
+	afterResponse     []AfterResponseHook
+	logger            *slog.Logger
+	uploadConcurrency int
+}
+
+// NewClient creates a new, independent VercelClient configured with the given options.
+func NewClient(opts ...Option) *VercelClient {
+	c := &VercelClient{
+		baseURL:           config.BaseURL,
+		userAgent:         DefaultUserAgent,
+		httpClient:        newDefaultHTTPClient(),
+		timeout:           DefaultTimeout,
+		uploadTimeout:     DefaultUploadTimeout,
+		retryPolicy:       DefaultRetryPolicy(),
+		logger:            slog.New(slog.DiscardHandler),
+		uploadConcurrency: DefaultUploadConcurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
+// GetVercelClient returns a process-wide VercelClient created with the given token.
+// Only the token of the first call is used; later calls return the same client.
+//
+// Deprecated: use NewClient, which returns independent clients.
 func GetVercelClient(token string) *VercelClient {
 	vercelClientOnce.Do(func() {
-		vercelClient = &VercelClient{
-			Token: token,
-		}
+		vercelClient = NewClient(WithToken(token))
 	})
 	return vercelClient
 }
 
+// settings returns the client holding the settings of c: c itself when it was created with NewClient,
+// which always sets an HTTP client, or a client with the defaults of NewClient when c is a struct literal.
+// Only the token, team and hooks of a struct literal are read from c.
+func (c *VercelClient) settings() *VercelClient {
+	if c.httpClient == nil {
+		return defaultClient()
+	}
+	return c
+}
+
 func (c *VercelClient) GetHeaders() map[string]string {
 	return map[string]string{
 		"Authorization": "Bearer " + c.Token,
 		"Content-Type":  "application/json",
+		"User-Agent":    c.settings().userAgent,
+	}
+}
+
+// LogValue implements slog.LogValuer so that logging a client never exposes its token.
+func (c *VercelClient) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("baseURL", c.settings().baseURL),
+		slog.String("teamId", c.teamID),
+		slog.String("token", "REDACTED"),
+	)
+}
+
+// HTTPClient returns the HTTP client every request of c is sent through.
+func (c *VercelClient) HTTPClient() *http.Client {
+	return c.settings().httpClient
+}
+
+// newDefaultHTTPClient returns an HTTP client whose transport keeps enough idle connections
+// per host for concurrent API calls and file uploads. Timeouts are applied per request through contexts.
+func newDefaultHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 32
+	transport.IdleConnTimeout = 90 * time.Second
+	return &http.Client{Transport: transport}
+}
+
+// BaseURL returns the API base URL every endpoint of c is resolved against.
+func (c *VercelClient) BaseURL() string {
+	return c.settings().baseURL
+}
+
+// url builds an absolute API URL from a path format relative to the client base URL.
+// String arguments are escaped as path segments and empty query values are dropped.
+func (c *VercelClient) url(query url.Values, format string, args ...any) string {
+	for i, arg := range args {
+		if s, ok := arg.(string); ok {
+			args[i] = url.PathEscape(s)
+		}
+	}
+
+	u := c.settings().baseURL + fmt.Sprintf(format, args...)
+	for key, values := range query {
+		if len(values) == 0 || (len(values) == 1 && values[0] == "") {
+			query.Del(key)
+		}
+	}
+	if encoded := query.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	return u
+}
+
+// teamQuery returns the query parameters scoping a request to teamId.
+func teamQuery(teamId string) url.Values {
+	return url.Values{"teamId": {teamId}}
+}
+
+// team returns teamId, or the client default team when teamId is empty.
+func (c *VercelClient) team(teamId string) string {
+	if teamId == "" {
+		return c.teamID
+	}
+	return teamId
+}
+
+// withTimeout derives a context bounded by timeout, or ctx itself when timeout is zero.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeout(ctx, timeout)
 }
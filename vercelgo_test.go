@@ -0,0 +1,33 @@
+package vercelgo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/config"
+)
+
+func TestStructLiteralClient(t *testing.T) {
+	c := &vercelgo.VercelClient{Token: "token"}
+	if got := c.BaseURL(); got != config.BaseURL {
+		t.Errorf("BaseURL = %q, want %q", got, config.BaseURL)
+	}
+	if c.HTTPClient() == nil {
+		t.Fatal("HTTPClient = nil, want the default client")
+	}
+	if other := (&vercelgo.VercelClient{Token: "other"}); other.HTTPClient() != c.HTTPClient() {
+		t.Error("struct literal clients use different HTTP clients, want one shared pool")
+	}
+	if got := c.GetHeaders()["User-Agent"]; got != vercelgo.DefaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", got, vercelgo.DefaultUserAgent)
+	}
+
+	// A canceled context stops the request before it reaches the network.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetTeamCtx(ctx, "team_1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetTeamCtx error = %v, want context.Canceled", err)
+	}
+}
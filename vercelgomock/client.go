@@ -0,0 +1,478 @@
+// Code generated by internal/mockgen from api.go; DO NOT EDIT.
+
+package vercelgomock
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"iter"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// Client is a mock whose methods forward to the function field of the same name suffixed with Func.
+// Calling a method whose field is nil returns ErrNotMocked.
+type Client struct {
+	calls callLog
+
+	CreateProjectCtxFunc        func(ctx context.Context, payload schemas.CreateProjectRequest, teamId string, framework schemas.VercelFramework) (*schemas.Project, error)
+	UpdateProjectCtxFunc        func(ctx context.Context, projectIdOrName string, payload schemas.CreateProjectRequest, teamId string) (*schemas.Project, error)
+	DeleteProjectCtxFunc        func(ctx context.Context, projectIdOrName string, teamId string) error
+	PauseProjectCtxFunc         func(ctx context.Context, projectIdOrName string, teamId string) error
+	UnpauseProjectCtxFunc       func(ctx context.Context, projectIdOrName string, teamId string) error
+	IterProjectsFunc            func(ctx context.Context, teamId string, filter *schemas.Filter) iter.Seq2[schemas.Project, error]
+	ListProjectsAllFunc         func(ctx context.Context, teamId string, filter *schemas.Filter) ([]schemas.Project, error)
+	GetTeamCtxFunc              func(ctx context.Context, teamId string) (*schemas.Team, error)
+	ListTeamsCtxFunc            func(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error)
+	IterTeamsFunc               func(ctx context.Context, filter *schemas.Filter) iter.Seq2[schemas.Team, error]
+	ListTeamsAllFunc            func(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error)
+	CreateTeamCtxFunc           func(ctx context.Context, slug string, name string) (string, error)
+	UpdateTeamCtxFunc           func(ctx context.Context, teamId string, name string, slug string) (*schemas.Team, error)
+	DeleteTeamCtxFunc           func(ctx context.Context, teamId string, reasons []schemas.Reason) error
+	AddProjectDomainCtxFunc     func(ctx context.Context, domainName string, teamId string, projectIdOrName string) (*schemas.AllDomainWithVerification, error)
+	DeleteProjectDomainCtxFunc  func(ctx context.Context, domainName string, projectIdOrName string, teamId string) (*schemas.AllDomainWithVerification, error)
+	GetProjectDomainsCtxFunc    func(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) (*schemas.AllDomainWithVerification, error)
+	IterProjectDomainsFunc      func(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) iter.Seq2[schemas.DomainInfo, error]
+	ListProjectDomainsAllFunc   func(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) ([]schemas.DomainInfo, error)
+	GetDomainConfigCtxFunc      func(ctx context.Context, domainName string, teamId string) (*schemas.DomainConfigInfo, error)
+	ForceDNSVerificationCtxFunc func(ctx context.Context, domainName string, projectId string, teamId string) (*schemas.ProjectDomanVerification, error)
+	DeployCtxFunc               func(ctx context.Context, projectId string, deploymentName string, directory string, teamId string, target string, opts ...vercelgo.DeployOption) (*schemas.AllDomainWithVerification, string, error)
+	DeployDirFunc               func(ctx context.Context, projectId string, deploymentName string, directory string, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error)
+	DeployFSFunc                func(ctx context.Context, projectId string, deploymentName string, fsys fs.FS, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error)
+	DeployWithOptionsFunc       func(ctx context.Context, req schemas.CreateDeploymentRequest, fsys fs.FS, teamId string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error)
+	DeployGitSourceFunc         func(ctx context.Context, projectId string, deploymentName string, source schemas.GitSource, teamId string, target string) (*schemas.DeploymentResponse, error)
+	DeployFilesFunc             func(ctx context.Context, projectId string, deploymentName string, files []vercelgo.DeployFile, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error)
+	GetDeploymentsCtxFunc       func(ctx context.Context, projectId string, teamId string) ([]schemas.DeploymentResponse, error)
+	IterDeploymentsFunc         func(ctx context.Context, projectId string, teamId string) iter.Seq2[schemas.DeploymentResponse, error]
+	ListDeploymentsAllFunc      func(ctx context.Context, projectId string, teamId string) ([]schemas.DeploymentResponse, error)
+	GetDeploymentStatusCtxFunc  func(ctx context.Context, deploymentId string, teamId string) (*schemas.DeploymentStatus, error)
+	WaitForDeploymentCtxFunc    func(ctx context.Context, deploymentId string, teamId string, timeout time.Duration) (*schemas.DeploymentStatus, error)
+	WaitDeploymentFunc          func(ctx context.Context, deploymentId string, teamId string, opts ...vercelgo.WaitOption) (*schemas.DeploymentStatus, error)
+	DeleteDeploymentCtxFunc     func(ctx context.Context, deploymentId string, teamId string) error
+	GetCurrentDeploymentCtxFunc func(ctx context.Context, projectId string, teamId string) (*schemas.CurrentDeployment, error)
+	CleanDeploymentsCtxFunc     func(ctx context.Context, projectId string, teamId string) error
+	GetDeploymentLogsCtxFunc    func(ctx context.Context, projectId string, teamId string) ([]schemas.DeployLogsResponse, error)
+	IterDeploymentLogsFunc      func(ctx context.Context, deploymentId string, teamId string, opts *schemas.DeploymentLogsOptions) iter.Seq2[schemas.DeployLogsResponse, error]
+	ListDeploymentLogsAllFunc   func(ctx context.Context, deploymentId string, teamId string, opts *schemas.DeploymentLogsOptions) ([]schemas.DeployLogsResponse, error)
+	StreamDeploymentEventsFunc  func(ctx context.Context, deploymentId string, teamId string) iter.Seq2[schemas.DeployLogsResponse, error]
+	ExportDeploymentLogsFunc    func(ctx context.Context, w io.Writer, deploymentId string, teamId string, format vercelgo.LogFormat, opts *schemas.DeploymentLogsOptions) error
+}
+
+// CreateProjectCtx records the call and forwards it to CreateProjectCtxFunc.
+func (m *Client) CreateProjectCtx(ctx context.Context, payload schemas.CreateProjectRequest, teamId string, framework schemas.VercelFramework) (*schemas.Project, error) {
+	m.calls.record("CreateProjectCtx", ctx, payload, teamId, framework)
+	if m.CreateProjectCtxFunc == nil {
+		var r0 *schemas.Project
+		return r0, notMocked("CreateProjectCtx")
+	}
+	return m.CreateProjectCtxFunc(ctx, payload, teamId, framework)
+}
+
+// UpdateProjectCtx records the call and forwards it to UpdateProjectCtxFunc.
+func (m *Client) UpdateProjectCtx(ctx context.Context, projectIdOrName string, payload schemas.CreateProjectRequest, teamId string) (*schemas.Project, error) {
+	m.calls.record("UpdateProjectCtx", ctx, projectIdOrName, payload, teamId)
+	if m.UpdateProjectCtxFunc == nil {
+		var r0 *schemas.Project
+		return r0, notMocked("UpdateProjectCtx")
+	}
+	return m.UpdateProjectCtxFunc(ctx, projectIdOrName, payload, teamId)
+}
+
+// DeleteProjectCtx records the call and forwards it to DeleteProjectCtxFunc.
+func (m *Client) DeleteProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	m.calls.record("DeleteProjectCtx", ctx, projectIdOrName, teamId)
+	if m.DeleteProjectCtxFunc == nil {
+		return notMocked("DeleteProjectCtx")
+	}
+	return m.DeleteProjectCtxFunc(ctx, projectIdOrName, teamId)
+}
+
+// PauseProjectCtx records the call and forwards it to PauseProjectCtxFunc.
+func (m *Client) PauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	m.calls.record("PauseProjectCtx", ctx, projectIdOrName, teamId)
+	if m.PauseProjectCtxFunc == nil {
+		return notMocked("PauseProjectCtx")
+	}
+	return m.PauseProjectCtxFunc(ctx, projectIdOrName, teamId)
+}
+
+// UnpauseProjectCtx records the call and forwards it to UnpauseProjectCtxFunc.
+func (m *Client) UnpauseProjectCtx(ctx context.Context, projectIdOrName string, teamId string) error {
+	m.calls.record("UnpauseProjectCtx", ctx, projectIdOrName, teamId)
+	if m.UnpauseProjectCtxFunc == nil {
+		return notMocked("UnpauseProjectCtx")
+	}
+	return m.UnpauseProjectCtxFunc(ctx, projectIdOrName, teamId)
+}
+
+// IterProjects records the call and forwards it to IterProjectsFunc.
+func (m *Client) IterProjects(ctx context.Context, teamId string, filter *schemas.Filter) iter.Seq2[schemas.Project, error] {
+	m.calls.record("IterProjects", ctx, teamId, filter)
+	if m.IterProjectsFunc == nil {
+		return func(yield func(schemas.Project, error) bool) {
+			var zero schemas.Project
+			yield(zero, notMocked("IterProjects"))
+		}
+	}
+	return m.IterProjectsFunc(ctx, teamId, filter)
+}
+
+// ListProjectsAll records the call and forwards it to ListProjectsAllFunc.
+func (m *Client) ListProjectsAll(ctx context.Context, teamId string, filter *schemas.Filter) ([]schemas.Project, error) {
+	m.calls.record("ListProjectsAll", ctx, teamId, filter)
+	if m.ListProjectsAllFunc == nil {
+		var r0 []schemas.Project
+		return r0, notMocked("ListProjectsAll")
+	}
+	return m.ListProjectsAllFunc(ctx, teamId, filter)
+}
+
+// GetTeamCtx records the call and forwards it to GetTeamCtxFunc.
+func (m *Client) GetTeamCtx(ctx context.Context, teamId string) (*schemas.Team, error) {
+	m.calls.record("GetTeamCtx", ctx, teamId)
+	if m.GetTeamCtxFunc == nil {
+		var r0 *schemas.Team
+		return r0, notMocked("GetTeamCtx")
+	}
+	return m.GetTeamCtxFunc(ctx, teamId)
+}
+
+// ListTeamsCtx records the call and forwards it to ListTeamsCtxFunc.
+func (m *Client) ListTeamsCtx(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error) {
+	m.calls.record("ListTeamsCtx", ctx, filter)
+	if m.ListTeamsCtxFunc == nil {
+		var r0 []schemas.Team
+		return r0, notMocked("ListTeamsCtx")
+	}
+	return m.ListTeamsCtxFunc(ctx, filter)
+}
+
+// IterTeams records the call and forwards it to IterTeamsFunc.
+func (m *Client) IterTeams(ctx context.Context, filter *schemas.Filter) iter.Seq2[schemas.Team, error] {
+	m.calls.record("IterTeams", ctx, filter)
+	if m.IterTeamsFunc == nil {
+		return func(yield func(schemas.Team, error) bool) {
+			var zero schemas.Team
+			yield(zero, notMocked("IterTeams"))
+		}
+	}
+	return m.IterTeamsFunc(ctx, filter)
+}
+
+// ListTeamsAll records the call and forwards it to ListTeamsAllFunc.
+func (m *Client) ListTeamsAll(ctx context.Context, filter *schemas.Filter) ([]schemas.Team, error) {
+	m.calls.record("ListTeamsAll", ctx, filter)
+	if m.ListTeamsAllFunc == nil {
+		var r0 []schemas.Team
+		return r0, notMocked("ListTeamsAll")
+	}
+	return m.ListTeamsAllFunc(ctx, filter)
+}
+
+// CreateTeamCtx records the call and forwards it to CreateTeamCtxFunc.
+func (m *Client) CreateTeamCtx(ctx context.Context, slug string, name string) (string, error) {
+	m.calls.record("CreateTeamCtx", ctx, slug, name)
+	if m.CreateTeamCtxFunc == nil {
+		var r0 string
+		return r0, notMocked("CreateTeamCtx")
+	}
+	return m.CreateTeamCtxFunc(ctx, slug, name)
+}
+
+// UpdateTeamCtx records the call and forwards it to UpdateTeamCtxFunc.
+func (m *Client) UpdateTeamCtx(ctx context.Context, teamId string, name string, slug string) (*schemas.Team, error) {
+	m.calls.record("UpdateTeamCtx", ctx, teamId, name, slug)
+	if m.UpdateTeamCtxFunc == nil {
+		var r0 *schemas.Team
+		return r0, notMocked("UpdateTeamCtx")
+	}
+	return m.UpdateTeamCtxFunc(ctx, teamId, name, slug)
+}
+
+// DeleteTeamCtx records the call and forwards it to DeleteTeamCtxFunc.
+func (m *Client) DeleteTeamCtx(ctx context.Context, teamId string, reasons []schemas.Reason) error {
+	m.calls.record("DeleteTeamCtx", ctx, teamId, reasons)
+	if m.DeleteTeamCtxFunc == nil {
+		return notMocked("DeleteTeamCtx")
+	}
+	return m.DeleteTeamCtxFunc(ctx, teamId, reasons)
+}
+
+// AddProjectDomainCtx records the call and forwards it to AddProjectDomainCtxFunc.
+func (m *Client) AddProjectDomainCtx(ctx context.Context, domainName string, teamId string, projectIdOrName string) (*schemas.AllDomainWithVerification, error) {
+	m.calls.record("AddProjectDomainCtx", ctx, domainName, teamId, projectIdOrName)
+	if m.AddProjectDomainCtxFunc == nil {
+		var r0 *schemas.AllDomainWithVerification
+		return r0, notMocked("AddProjectDomainCtx")
+	}
+	return m.AddProjectDomainCtxFunc(ctx, domainName, teamId, projectIdOrName)
+}
+
+// DeleteProjectDomainCtx records the call and forwards it to DeleteProjectDomainCtxFunc.
+func (m *Client) DeleteProjectDomainCtx(ctx context.Context, domainName string, projectIdOrName string, teamId string) (*schemas.AllDomainWithVerification, error) {
+	m.calls.record("DeleteProjectDomainCtx", ctx, domainName, projectIdOrName, teamId)
+	if m.DeleteProjectDomainCtxFunc == nil {
+		var r0 *schemas.AllDomainWithVerification
+		return r0, notMocked("DeleteProjectDomainCtx")
+	}
+	return m.DeleteProjectDomainCtxFunc(ctx, domainName, projectIdOrName, teamId)
+}
+
+// GetProjectDomainsCtx records the call and forwards it to GetProjectDomainsCtxFunc.
+func (m *Client) GetProjectDomainsCtx(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) (*schemas.AllDomainWithVerification, error) {
+	m.calls.record("GetProjectDomainsCtx", ctx, projectIdOrName, teamId, opts)
+	if m.GetProjectDomainsCtxFunc == nil {
+		var r0 *schemas.AllDomainWithVerification
+		return r0, notMocked("GetProjectDomainsCtx")
+	}
+	return m.GetProjectDomainsCtxFunc(ctx, projectIdOrName, teamId, opts)
+}
+
+// IterProjectDomains records the call and forwards it to IterProjectDomainsFunc.
+func (m *Client) IterProjectDomains(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) iter.Seq2[schemas.DomainInfo, error] {
+	m.calls.record("IterProjectDomains", ctx, projectIdOrName, teamId, opts)
+	if m.IterProjectDomainsFunc == nil {
+		return func(yield func(schemas.DomainInfo, error) bool) {
+			var zero schemas.DomainInfo
+			yield(zero, notMocked("IterProjectDomains"))
+		}
+	}
+	return m.IterProjectDomainsFunc(ctx, projectIdOrName, teamId, opts)
+}
+
+// ListProjectDomainsAll records the call and forwards it to ListProjectDomainsAllFunc.
+func (m *Client) ListProjectDomainsAll(ctx context.Context, projectIdOrName string, teamId string, opts *schemas.Options) ([]schemas.DomainInfo, error) {
+	m.calls.record("ListProjectDomainsAll", ctx, projectIdOrName, teamId, opts)
+	if m.ListProjectDomainsAllFunc == nil {
+		var r0 []schemas.DomainInfo
+		return r0, notMocked("ListProjectDomainsAll")
+	}
+	return m.ListProjectDomainsAllFunc(ctx, projectIdOrName, teamId, opts)
+}
+
+// GetDomainConfigCtx records the call and forwards it to GetDomainConfigCtxFunc.
+func (m *Client) GetDomainConfigCtx(ctx context.Context, domainName string, teamId string) (*schemas.DomainConfigInfo, error) {
+	m.calls.record("GetDomainConfigCtx", ctx, domainName, teamId)
+	if m.GetDomainConfigCtxFunc == nil {
+		var r0 *schemas.DomainConfigInfo
+		return r0, notMocked("GetDomainConfigCtx")
+	}
+	return m.GetDomainConfigCtxFunc(ctx, domainName, teamId)
+}
+
+// ForceDNSVerificationCtx records the call and forwards it to ForceDNSVerificationCtxFunc.
+func (m *Client) ForceDNSVerificationCtx(ctx context.Context, domainName string, projectId string, teamId string) (*schemas.ProjectDomanVerification, error) {
+	m.calls.record("ForceDNSVerificationCtx", ctx, domainName, projectId, teamId)
+	if m.ForceDNSVerificationCtxFunc == nil {
+		var r0 *schemas.ProjectDomanVerification
+		return r0, notMocked("ForceDNSVerificationCtx")
+	}
+	return m.ForceDNSVerificationCtxFunc(ctx, domainName, projectId, teamId)
+}
+
+// DeployCtx records the call and forwards it to DeployCtxFunc.
+func (m *Client) DeployCtx(ctx context.Context, projectId string, deploymentName string, directory string, teamId string, target string, opts ...vercelgo.DeployOption) (*schemas.AllDomainWithVerification, string, error) {
+	m.calls.record("DeployCtx", ctx, projectId, deploymentName, directory, teamId, target, opts)
+	if m.DeployCtxFunc == nil {
+		var r0 *schemas.AllDomainWithVerification
+		var r1 string
+		return r0, r1, notMocked("DeployCtx")
+	}
+	return m.DeployCtxFunc(ctx, projectId, deploymentName, directory, teamId, target, opts...)
+}
+
+// DeployDir records the call and forwards it to DeployDirFunc.
+func (m *Client) DeployDir(ctx context.Context, projectId string, deploymentName string, directory string, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+	m.calls.record("DeployDir", ctx, projectId, deploymentName, directory, teamId, target, opts)
+	if m.DeployDirFunc == nil {
+		var r0 *vercelgo.DeployResult
+		return r0, notMocked("DeployDir")
+	}
+	return m.DeployDirFunc(ctx, projectId, deploymentName, directory, teamId, target, opts...)
+}
+
+// DeployFS records the call and forwards it to DeployFSFunc.
+func (m *Client) DeployFS(ctx context.Context, projectId string, deploymentName string, fsys fs.FS, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+	m.calls.record("DeployFS", ctx, projectId, deploymentName, fsys, teamId, target, opts)
+	if m.DeployFSFunc == nil {
+		var r0 *vercelgo.DeployResult
+		return r0, notMocked("DeployFS")
+	}
+	return m.DeployFSFunc(ctx, projectId, deploymentName, fsys, teamId, target, opts...)
+}
+
+// DeployWithOptions records the call and forwards it to DeployWithOptionsFunc.
+func (m *Client) DeployWithOptions(ctx context.Context, req schemas.CreateDeploymentRequest, fsys fs.FS, teamId string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+	m.calls.record("DeployWithOptions", ctx, req, fsys, teamId, opts)
+	if m.DeployWithOptionsFunc == nil {
+		var r0 *vercelgo.DeployResult
+		return r0, notMocked("DeployWithOptions")
+	}
+	return m.DeployWithOptionsFunc(ctx, req, fsys, teamId, opts...)
+}
+
+// DeployGitSource records the call and forwards it to DeployGitSourceFunc.
+func (m *Client) DeployGitSource(ctx context.Context, projectId string, deploymentName string, source schemas.GitSource, teamId string, target string) (*schemas.DeploymentResponse, error) {
+	m.calls.record("DeployGitSource", ctx, projectId, deploymentName, source, teamId, target)
+	if m.DeployGitSourceFunc == nil {
+		var r0 *schemas.DeploymentResponse
+		return r0, notMocked("DeployGitSource")
+	}
+	return m.DeployGitSourceFunc(ctx, projectId, deploymentName, source, teamId, target)
+}
+
+// DeployFiles records the call and forwards it to DeployFilesFunc.
+func (m *Client) DeployFiles(ctx context.Context, projectId string, deploymentName string, files []vercelgo.DeployFile, teamId string, target string, opts ...vercelgo.DeployOption) (*vercelgo.DeployResult, error) {
+	m.calls.record("DeployFiles", ctx, projectId, deploymentName, files, teamId, target, opts)
+	if m.DeployFilesFunc == nil {
+		var r0 *vercelgo.DeployResult
+		return r0, notMocked("DeployFiles")
+	}
+	return m.DeployFilesFunc(ctx, projectId, deploymentName, files, teamId, target, opts...)
+}
+
+// GetDeploymentsCtx records the call and forwards it to GetDeploymentsCtxFunc.
+func (m *Client) GetDeploymentsCtx(ctx context.Context, projectId string, teamId string) ([]schemas.DeploymentResponse, error) {
+	m.calls.record("GetDeploymentsCtx", ctx, projectId, teamId)
+	if m.GetDeploymentsCtxFunc == nil {
+		var r0 []schemas.DeploymentResponse
+		return r0, notMocked("GetDeploymentsCtx")
+	}
+	return m.GetDeploymentsCtxFunc(ctx, projectId, teamId)
+}
+
+// IterDeployments records the call and forwards it to IterDeploymentsFunc.
+func (m *Client) IterDeployments(ctx context.Context, projectId string, teamId string) iter.Seq2[schemas.DeploymentResponse, error] {
+	m.calls.record("IterDeployments", ctx, projectId, teamId)
+	if m.IterDeploymentsFunc == nil {
+		return func(yield func(schemas.DeploymentResponse, error) bool) {
+			var zero schemas.DeploymentResponse
+			yield(zero, notMocked("IterDeployments"))
+		}
+	}
+	return m.IterDeploymentsFunc(ctx, projectId, teamId)
+}
+
+// ListDeploymentsAll records the call and forwards it to ListDeploymentsAllFunc.
+func (m *Client) ListDeploymentsAll(ctx context.Context, projectId string, teamId string) ([]schemas.DeploymentResponse, error) {
+	m.calls.record("ListDeploymentsAll", ctx, projectId, teamId)
+	if m.ListDeploymentsAllFunc == nil {
+		var r0 []schemas.DeploymentResponse
+		return r0, notMocked("ListDeploymentsAll")
+	}
+	return m.ListDeploymentsAllFunc(ctx, projectId, teamId)
+}
+
+// GetDeploymentStatusCtx records the call and forwards it to GetDeploymentStatusCtxFunc.
+func (m *Client) GetDeploymentStatusCtx(ctx context.Context, deploymentId string, teamId string) (*schemas.DeploymentStatus, error) {
+	m.calls.record("GetDeploymentStatusCtx", ctx, deploymentId, teamId)
+	if m.GetDeploymentStatusCtxFunc == nil {
+		var r0 *schemas.DeploymentStatus
+		return r0, notMocked("GetDeploymentStatusCtx")
+	}
+	return m.GetDeploymentStatusCtxFunc(ctx, deploymentId, teamId)
+}
+
+// WaitForDeploymentCtx records the call and forwards it to WaitForDeploymentCtxFunc.
+func (m *Client) WaitForDeploymentCtx(ctx context.Context, deploymentId string, teamId string, timeout time.Duration) (*schemas.DeploymentStatus, error) {
+	m.calls.record("WaitForDeploymentCtx", ctx, deploymentId, teamId, timeout)
+	if m.WaitForDeploymentCtxFunc == nil {
+		var r0 *schemas.DeploymentStatus
+		return r0, notMocked("WaitForDeploymentCtx")
+	}
+	return m.WaitForDeploymentCtxFunc(ctx, deploymentId, teamId, timeout)
+}
+
+// WaitDeployment records the call and forwards it to WaitDeploymentFunc.
+func (m *Client) WaitDeployment(ctx context.Context, deploymentId string, teamId string, opts ...vercelgo.WaitOption) (*schemas.DeploymentStatus, error) {
+	m.calls.record("WaitDeployment", ctx, deploymentId, teamId, opts)
+	if m.WaitDeploymentFunc == nil {
+		var r0 *schemas.DeploymentStatus
+		return r0, notMocked("WaitDeployment")
+	}
+	return m.WaitDeploymentFunc(ctx, deploymentId, teamId, opts...)
+}
+
+// DeleteDeploymentCtx records the call and forwards it to DeleteDeploymentCtxFunc.
+func (m *Client) DeleteDeploymentCtx(ctx context.Context, deploymentId string, teamId string) error {
+	m.calls.record("DeleteDeploymentCtx", ctx, deploymentId, teamId)
+	if m.DeleteDeploymentCtxFunc == nil {
+		return notMocked("DeleteDeploymentCtx")
+	}
+	return m.DeleteDeploymentCtxFunc(ctx, deploymentId, teamId)
+}
+
+// GetCurrentDeploymentCtx records the call and forwards it to GetCurrentDeploymentCtxFunc.
+func (m *Client) GetCurrentDeploymentCtx(ctx context.Context, projectId string, teamId string) (*schemas.CurrentDeployment, error) {
+	m.calls.record("GetCurrentDeploymentCtx", ctx, projectId, teamId)
+	if m.GetCurrentDeploymentCtxFunc == nil {
+		var r0 *schemas.CurrentDeployment
+		return r0, notMocked("GetCurrentDeploymentCtx")
+	}
+	return m.GetCurrentDeploymentCtxFunc(ctx, projectId, teamId)
+}
+
+// CleanDeploymentsCtx records the call and forwards it to CleanDeploymentsCtxFunc.
+func (m *Client) CleanDeploymentsCtx(ctx context.Context, projectId string, teamId string) error {
+	m.calls.record("CleanDeploymentsCtx", ctx, projectId, teamId)
+	if m.CleanDeploymentsCtxFunc == nil {
+		return notMocked("CleanDeploymentsCtx")
+	}
+	return m.CleanDeploymentsCtxFunc(ctx, projectId, teamId)
+}
+
+// GetDeploymentLogsCtx records the call and forwards it to GetDeploymentLogsCtxFunc.
+func (m *Client) GetDeploymentLogsCtx(ctx context.Context, projectId string, teamId string) ([]schemas.DeployLogsResponse, error) {
+	m.calls.record("GetDeploymentLogsCtx", ctx, projectId, teamId)
+	if m.GetDeploymentLogsCtxFunc == nil {
+		var r0 []schemas.DeployLogsResponse
+		return r0, notMocked("GetDeploymentLogsCtx")
+	}
+	return m.GetDeploymentLogsCtxFunc(ctx, projectId, teamId)
+}
+
+// IterDeploymentLogs records the call and forwards it to IterDeploymentLogsFunc.
+func (m *Client) IterDeploymentLogs(ctx context.Context, deploymentId string, teamId string, opts *schemas.DeploymentLogsOptions) iter.Seq2[schemas.DeployLogsResponse, error] {
+	m.calls.record("IterDeploymentLogs", ctx, deploymentId, teamId, opts)
+	if m.IterDeploymentLogsFunc == nil {
+		return func(yield func(schemas.DeployLogsResponse, error) bool) {
+			var zero schemas.DeployLogsResponse
+			yield(zero, notMocked("IterDeploymentLogs"))
+		}
+	}
+	return m.IterDeploymentLogsFunc(ctx, deploymentId, teamId, opts)
+}
+
+// ListDeploymentLogsAll records the call and forwards it to ListDeploymentLogsAllFunc.
+func (m *Client) ListDeploymentLogsAll(ctx context.Context, deploymentId string, teamId string, opts *schemas.DeploymentLogsOptions) ([]schemas.DeployLogsResponse, error) {
+	m.calls.record("ListDeploymentLogsAll", ctx, deploymentId, teamId, opts)
+	if m.ListDeploymentLogsAllFunc == nil {
+		var r0 []schemas.DeployLogsResponse
+		return r0, notMocked("ListDeploymentLogsAll")
+	}
+	return m.ListDeploymentLogsAllFunc(ctx, deploymentId, teamId, opts)
+}
+
+// StreamDeploymentEvents records the call and forwards it to StreamDeploymentEventsFunc.
+func (m *Client) StreamDeploymentEvents(ctx context.Context, deploymentId string, teamId string) iter.Seq2[schemas.DeployLogsResponse, error] {
+	m.calls.record("StreamDeploymentEvents", ctx, deploymentId, teamId)
+	if m.StreamDeploymentEventsFunc == nil {
+		return func(yield func(schemas.DeployLogsResponse, error) bool) {
+			var zero schemas.DeployLogsResponse
+			yield(zero, notMocked("StreamDeploymentEvents"))
+		}
+	}
+	return m.StreamDeploymentEventsFunc(ctx, deploymentId, teamId)
+}
+
+// ExportDeploymentLogs records the call and forwards it to ExportDeploymentLogsFunc.
+func (m *Client) ExportDeploymentLogs(ctx context.Context, w io.Writer, deploymentId string, teamId string, format vercelgo.LogFormat, opts *schemas.DeploymentLogsOptions) error {
+	m.calls.record("ExportDeploymentLogs", ctx, w, deploymentId, teamId, format, opts)
+	if m.ExportDeploymentLogsFunc == nil {
+		return notMocked("ExportDeploymentLogs")
+	}
+	return m.ExportDeploymentLogsFunc(ctx, w, deploymentId, teamId, format, opts)
+}
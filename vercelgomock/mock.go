@@ -0,0 +1,67 @@
+// Package vercelgomock provides a mock implementation of vercelgo.API for unit tests
+// that should not issue any HTTP request.
+//
+//	client := &vercelgomock.Client{
+//		GetTeamCtxFunc: func(ctx context.Context, teamId string) (*schemas.Team, error) {
+//			return &schemas.Team{ID: teamId}, nil
+//		},
+//	}
+package vercelgomock
+
+//go:generate go run ../internal/mockgen -src ../api.go -iface API -import github.com/GitDocAI/vercelgo -pkg vercelgomock -type Client -out client.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/GitDocAI/vercelgo"
+)
+
+// ErrNotMocked is returned, wrapped, by Client methods whose function field is nil.
+var ErrNotMocked = errors.New("vercelgomock: method not mocked")
+
+// Call is a method call received by a Client.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Calls returns the calls received so far, in order.
+func (m *Client) Calls() []Call {
+	return m.calls.list()
+}
+
+// CallsTo returns the calls received so far for the given method, in order.
+func (m *Client) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range m.calls.list() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+type callLog struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (l *callLog) record(method string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, Call{Method: method, Args: args})
+}
+
+func (l *callLog) list() []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Call(nil), l.calls...)
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, method)
+}
+
+var _ vercelgo.API = (*Client)(nil)
@@ -0,0 +1,825 @@
+package vercelgotest
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/teams", s.listTeams)
+	mux.HandleFunc("POST /v1/teams", s.createTeam)
+	mux.HandleFunc("GET /v1/teams/{teamId}", s.getTeam)
+	mux.HandleFunc("PATCH /v1/teams/{teamId}", s.updateTeam)
+	mux.HandleFunc("DELETE /v1/teams/{teamId}", s.deleteTeam)
+
+	mux.HandleFunc("GET /v10/projects", s.listProjects)
+	mux.HandleFunc("POST /v11/projects", s.createProject)
+	mux.HandleFunc("PATCH /v9/projects/{project}", s.updateProject)
+	mux.HandleFunc("DELETE /v9/projects/{project}", s.deleteProject)
+	mux.HandleFunc("POST /v1/projects/{project}/pause", s.pauseProject(true))
+	mux.HandleFunc("POST /v1/projects/{project}/unpause", s.pauseProject(false))
+	mux.HandleFunc("GET /v1/projects/{project}/production-deployment", s.productionDeployment)
+
+	mux.HandleFunc("GET /v9/projects/{project}/domains", s.listDomains)
+	mux.HandleFunc("POST /v10/projects/{project}/domains", s.addProjectDomain)
+	mux.HandleFunc("DELETE /v9/projects/{project}/domains/{domain}", s.deleteDomain)
+	mux.HandleFunc("POST /v9/projects/{project}/domains/{domain}/verify", s.verifyDomain)
+	mux.HandleFunc("GET /v6/domains/{domain}/config", s.domainConfig)
+
+	mux.HandleFunc("POST /v2/files", s.uploadFile)
+	mux.HandleFunc("POST /v13/deployments", s.createDeployment)
+	mux.HandleFunc("GET /v13/deployments/{id}", s.getDeployment)
+	mux.HandleFunc("DELETE /v13/deployments/{id}", s.deleteDeployment)
+	mux.HandleFunc("GET /v6/deployments", s.listDeployments)
+	mux.HandleFunc("GET /v3/deployments/{id}/events", s.deploymentEvents)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// File uploads are streamed to their handler rather than kept, since they can be large.
+		var body []byte
+		if r.URL.Path != "/v2/files" {
+			body, _ = io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Body:   body,
+		})
+		token := s.Token
+		failure := s.failure(r)
+		s.mu.Unlock()
+
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			writeError(w, http.StatusForbidden, "forbidden", "Not authorized")
+			return
+		}
+		if failure != nil {
+			for key, values := range failure.Header {
+				w.Header()[key] = values
+			}
+			writeError(w, failure.Status, failure.Code, failure.Message)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// page returns the items created after the since and before the until query parameters,
+// newest first or oldest first when the order query parameter is ASC,
+// limited by the limit query parameter, along with the pagination of the result.
+func page[T any](r *http.Request, items []T, createdAt func(T) int64) ([]T, schemas.Pagination) {
+	query := r.URL.Query()
+	ascending := strings.EqualFold(query.Get("order"), "ASC")
+	slices.SortStableFunc(items, func(a, b T) int {
+		if ascending {
+			return cmp.Compare(createdAt(a), createdAt(b))
+		}
+		return cmp.Compare(createdAt(b), createdAt(a))
+	})
+
+	if since, err := strconv.ParseInt(query.Get("since"), 10, 64); err == nil {
+		items = slices.DeleteFunc(items, func(item T) bool { return createdAt(item) <= since })
+	}
+	if until, err := strconv.ParseInt(query.Get("until"), 10, 64); err == nil {
+		items = slices.DeleteFunc(items, func(item T) bool { return createdAt(item) >= until })
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+
+	var pagination schemas.Pagination
+	if len(items) > limit {
+		items = items[:limit]
+		pagination.Next = createdAt(items[limit-1])
+	}
+	pagination.Count = len(items)
+	return items, pagination
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// Teams
+
+func (s *Server) addTeam(team schemas.Team) schemas.Team {
+	if team.ID == "" {
+		team.ID = s.newID("team")
+	}
+	s.teams = append(s.teams, &teamRecord{team: team, createdAt: s.now()})
+	return team
+}
+
+func (s *Server) team(teamId string) *teamRecord {
+	for _, t := range s.teams {
+		if t.team.ID == teamId || t.team.Slug == teamId {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	teams, pagination := page(r, slices.Clone(s.teams), func(t *teamRecord) int64 { return t.createdAt })
+	response := schemas.ListTeamsResponse{Teams: []schemas.Team{}, Pagination: pagination}
+	for _, t := range teams {
+		response.Teams = append(response.Teams, t.team)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	var team schemas.Team
+	if !decode(w, r, &team) {
+		return
+	}
+	if team.Slug == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "slug is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.team(team.Slug) != nil {
+		writeError(w, http.StatusConflict, "slug_already_in_use", "The slug is already in use")
+		return
+	}
+	team.ID = ""
+	writeJSON(w, http.StatusOK, s.addTeam(team))
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.team(r.PathValue("teamId"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Team not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, t.team)
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	var update schemas.Team
+	if !decode(w, r, &update) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.team(r.PathValue("teamId"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Team not found")
+		return
+	}
+	if update.Name != "" {
+		t.team.Name = update.Name
+	}
+	if update.Slug != "" {
+		t.team.Slug = update.Slug
+	}
+	writeJSON(w, http.StatusOK, t.team)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.team(r.PathValue("teamId"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Team not found")
+		return
+	}
+	s.teams = slices.DeleteFunc(s.teams, func(other *teamRecord) bool { return other == t })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Projects
+
+func (s *Server) addProject(teamId string, project schemas.Project, settings schemas.CreateProjectRequest) schemas.Project {
+	if project.ID == "" {
+		project.ID = s.newID("prj")
+	}
+	if project.AccountID == "" {
+		project.AccountID = teamId
+	}
+	s.projects = append(s.projects, &projectRecord{
+		project:   project,
+		teamId:    teamId,
+		settings:  settings,
+		createdAt: s.now(),
+	})
+	return project
+}
+
+func (s *Server) project(projectIdOrName string) *projectRecord {
+	for _, p := range s.projects {
+		if p.project.ID == projectIdOrName || p.project.Name == projectIdOrName {
+			return p
+		}
+	}
+	return nil
+}
+
+// projectOrNotFound looks up the project of the request path, writing a 404 when it does not exist.
+func (s *Server) projectOrNotFound(w http.ResponseWriter, r *http.Request) *projectRecord {
+	p := s.project(r.PathValue("project"))
+	if p == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Project not found")
+	}
+	return p
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	teamId := r.URL.Query().Get("teamId")
+	search := r.URL.Query().Get("search")
+	var matching []*projectRecord
+	for _, p := range s.projects {
+		if p.teamId == teamId && strings.Contains(p.project.Name, search) {
+			matching = append(matching, p)
+		}
+	}
+
+	projects, pagination := page(r, matching, func(p *projectRecord) int64 { return p.createdAt })
+	response := schemas.ListProjectsResponse{Projects: []schemas.Project{}, Pagination: pagination}
+	for _, p := range projects {
+		response.Projects = append(response.Projects, p.project)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var request schemas.CreateProjectRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.project(request.Name) != nil {
+		writeError(w, http.StatusConflict, "conflict", "A project with this name already exists")
+		return
+	}
+	teamId := r.URL.Query().Get("teamId")
+	writeJSON(w, http.StatusOK, s.addProject(teamId, schemas.Project{Name: request.Name}, request))
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	var request schemas.CreateProjectRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+	if request.Name != "" {
+		p.project.Name = request.Name
+	}
+	p.settings = request
+	writeJSON(w, http.StatusOK, p.project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+	s.projects = slices.DeleteFunc(s.projects, func(other *projectRecord) bool { return other == p })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) pauseProject(paused bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		p := s.projectOrNotFound(w, r)
+		if p == nil {
+			return
+		}
+		p.paused = paused
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// Domains
+
+func (s *Server) addDomain(p *projectRecord, domain schemas.DomainInfo) {
+	if domain.ApexName == "" {
+		domain.ApexName = domain.Name
+	}
+	domain.ProjectID = p.project.ID
+	if domain.CreatedAt == 0 {
+		domain.CreatedAt = s.now()
+	}
+	if domain.UpdatedAt == 0 {
+		domain.UpdatedAt = domain.CreatedAt
+	}
+	p.domains = append(p.domains, &domain)
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+
+	domains, pagination := page(r, slices.Clone(p.domains), func(d *schemas.DomainInfo) int64 { return d.CreatedAt })
+	response := schemas.ProjectDomainsResponse{Domains: []schemas.DomainInfo{}, Pagination: pagination}
+	for _, d := range domains {
+		response.Domains = append(response.Domains, *d)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) addProjectDomain(w http.ResponseWriter, r *http.Request) {
+	var request schemas.Domain
+	if !decode(w, r, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+	for _, d := range p.domains {
+		if d.Name == request.Name {
+			writeError(w, http.StatusConflict, "domain_already_in_use", "The domain is already in use by the project")
+			return
+		}
+	}
+	s.addDomain(p, schemas.DomainInfo{Name: request.Name, Verified: request.Verified})
+	writeJSON(w, http.StatusOK, *p.domains[len(p.domains)-1])
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+	name := r.PathValue("domain")
+	index := slices.IndexFunc(p.domains, func(d *schemas.DomainInfo) bool { return d.Name == name })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "not_found", "Domain not found")
+		return
+	}
+	p.domains = slices.Delete(p.domains, index, index+1)
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) verifyDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+	name := r.PathValue("domain")
+	index := slices.IndexFunc(p.domains, func(d *schemas.DomainInfo) bool { return d.Name == name })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "not_found", "Domain not found")
+		return
+	}
+
+	d := p.domains[index]
+	d.Verified = true
+	d.Verification = nil
+	d.UpdatedAt = s.now()
+	writeJSON(w, http.StatusOK, schemas.ProjectDomanVerification{
+		Name:                d.Name,
+		ApexName:            d.ApexName,
+		ProjectId:           d.ProjectID,
+		Redirect:            d.Redirect,
+		RedirectStatusCode:  d.RedirectStatusCode,
+		GitBranch:           d.GitBranch,
+		CustomEnvironmentID: d.CustomEnvironmentID,
+		UpdatedAt:           time.UnixMilli(d.UpdatedAt),
+		CreatedAt:           time.UnixMilli(d.CreatedAt),
+		Verified:            d.Verified,
+	})
+}
+
+func (s *Server) domainConfig(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	config, ok := s.configs[r.PathValue("domain")]
+	if !ok {
+		config = schemas.DomainConfigInfo{
+			Nameservers:        []string{},
+			CNAMEs:             []string{},
+			AValues:            []string{},
+			Conflicts:          []string{},
+			AcceptedChallenges: []string{},
+			RecommendedIPv4:    []schemas.RecommendedIPv4Entry{{Rank: 1, Value: []string{"76.76.21.21"}}},
+			RecommendedCNAME:   []schemas.RecommendedCNAMEEntry{{Rank: 1, Value: "cname.vercel-dns.com."}},
+		}
+	}
+	writeJSON(w, http.StatusOK, config)
+}
+
+// Files and deployments
+
+func (s *Server) uploadFile(w http.ResponseWriter, r *http.Request) {
+	hash := sha1.New()
+	size, err := io.Copy(hash, r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err.Error())
+		return
+	}
+	digest := r.Header.Get("x-vercel-digest")
+	if digest == "" || digest != hex.EncodeToString(hash.Sum(nil)) {
+		writeError(w, http.StatusBadRequest, "invalid_digest", "The x-vercel-digest header does not match the file content")
+		return
+	}
+
+	s.mu.Lock()
+	s.files[digest] = size
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]any{"urls": []string{}})
+}
+
+func (s *Server) addDeployment(teamId, projectId string, deployment schemas.DeploymentResponse, states []schemas.ReadyState) *deploymentRecord {
+	if deployment.Id == "" {
+		deployment.Id = deployment.Uid
+	}
+	if deployment.Id == "" {
+		deployment.Id = s.newID("dpl")
+	}
+	deployment.Uid = deployment.Id
+	if deployment.Url == "" {
+		deployment.Url = strings.ToLower(deployment.Id) + ".vercel.app"
+	}
+	if deployment.InspectorUrl == "" {
+		deployment.InspectorUrl = "https://vercel.com/" + teamId + "/" + projectId + "/" + deployment.Id
+	}
+	if deployment.CreatedAt == 0 {
+		deployment.CreatedAt = s.now()
+	}
+	if len(states) == 0 {
+		states = s.DeploymentStates
+	}
+
+	d := &deploymentRecord{
+		deployment: deployment,
+		projectId:  projectId,
+		teamId:     teamId,
+		states:     slices.Clone(states),
+	}
+	if deployment.ReadyState == "" {
+		s.advance(d)
+	}
+	s.deployments = append(s.deployments, d)
+	return d
+}
+
+func (s *Server) deployment(deploymentId string) *deploymentRecord {
+	for _, d := range s.deployments {
+		if d.deployment.Id == deploymentId || d.deployment.Url == deploymentId {
+			return d
+		}
+	}
+	return nil
+}
+
+// advance moves a deployment to its next scripted state, if any.
+func (s *Server) advance(d *deploymentRecord) {
+	if len(d.states) == 0 {
+		return
+	}
+	state := d.states[0]
+	d.states = d.states[1:]
+	s.setState(d, state)
+}
+
+// setState updates the ready state of a deployment and records the matching build event.
+func (s *Server) setState(d *deploymentRecord, state schemas.ReadyState) {
+	if d.deployment.ReadyState == state {
+		return
+	}
+	d.deployment.ReadyState = state
+	d.deployment.Status = state
+
+	eventType, text := schemas.Stdout, "Deployment state changed to "+string(state)
+	switch state {
+	case schemas.ReadyStateError:
+		if d.errorMessage == "" {
+			d.errorCode, d.errorMessage, d.errorStep = "BUILD_FAILED", `Command "vercel build" exited with 1`, "build"
+		}
+		eventType, text = schemas.StdErr, "Error: "+d.errorMessage
+	case schemas.ReadyStateReady, schemas.ReadyStateCanceled:
+		eventType = schemas.Exit
+	}
+	now := s.now()
+	d.events = append(d.events, schemas.DeployLogsResponse{
+		Type:    eventType,
+		Created: now,
+		Payload: schemas.DeployLogPayload{
+			DeploymentId: d.deployment.Id,
+			Text:         text,
+			Date:         now,
+		},
+	})
+	s.notify()
+}
+
+func (s *Server) createDeployment(w http.ResponseWriter, r *http.Request) {
+	var request schemas.CreateDeploymentRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+	if g := request.GitSource; g != nil {
+		switch {
+		case len(request.Files) > 0:
+			writeError(w, http.StatusBadRequest, "bad_request", "files and gitSource are mutually exclusive")
+			return
+		case !slices.Contains([]schemas.GitSourceType{schemas.GitSourceGitHub, schemas.GitSourceGitLab, schemas.GitSourceBitbucket}, g.Type):
+			writeError(w, http.StatusBadRequest, "bad_request", "unsupported gitSource type")
+			return
+		case g.Ref == "":
+			writeError(w, http.StatusBadRequest, "bad_request", "gitSource ref is required")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	missing := []string{}
+	for _, f := range request.Files {
+		if _, ok := s.files[f.Sha]; !ok && !slices.Contains(missing, f.Sha) {
+			missing = append(missing, f.Sha)
+		}
+	}
+	if len(missing) > 0 {
+		writeErrorBody(w, http.StatusBadRequest, map[string]any{
+			"code":    "missing_files",
+			"message": "Missing files",
+			"missing": missing,
+		})
+		return
+	}
+
+	projectId := request.Project
+	if projectId == "" {
+		projectId = request.Name
+	}
+	p := s.project(projectId)
+	if p == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Project not found")
+		return
+	}
+
+	d := s.addDeployment(r.URL.Query().Get("teamId"), p.project.ID, schemas.DeploymentResponse{
+		Files:     request.Files,
+		Target:    request.Target,
+		Meta:      request.Meta,
+		GitSource: request.GitSource,
+	}, nil)
+	d.name = request.Name
+	d.events = append([]schemas.DeployLogsResponse{{
+		Type:    schemas.Command,
+		Created: d.deployment.CreatedAt,
+		Payload: schemas.DeployLogPayload{
+			DeploymentId: d.deployment.Id,
+			Text:         "vercel build",
+			Date:         d.deployment.CreatedAt,
+		},
+	}}, d.events...)
+	writeJSON(w, http.StatusOK, d.deployment)
+}
+
+// deploymentStatus is the body returned for a single deployment.
+type deploymentStatus struct {
+	schemas.DeploymentResponse
+	Name         string `json:"name"`
+	ProjectId    string `json:"projectId"`
+	ErrorCode    string `json:"errorCode,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	ErrorStep    string `json:"errorStep,omitempty"`
+}
+
+func (s *Server) getDeployment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(r.PathValue("id"))
+	if d == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Deployment not found")
+		return
+	}
+	s.advance(d)
+	status := deploymentStatus{
+		DeploymentResponse: d.deployment,
+		Name:               d.name,
+		ProjectId:          d.projectId,
+	}
+	if d.deployment.ReadyState == schemas.ReadyStateError {
+		status.ErrorCode, status.ErrorMessage, status.ErrorStep = d.errorCode, d.errorMessage, d.errorStep
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *Server) deleteDeployment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(r.PathValue("id"))
+	if d == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Deployment not found")
+		return
+	}
+	s.deployments = slices.DeleteFunc(s.deployments, func(other *deploymentRecord) bool { return other == d })
+	writeJSON(w, http.StatusOK, map[string]string{"uid": d.deployment.Id, "state": "DELETED"})
+}
+
+func (s *Server) listDeployments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	projectId := r.URL.Query().Get("projectId")
+	if p := s.project(projectId); p != nil {
+		projectId = p.project.ID
+	}
+	var matching []*deploymentRecord
+	for _, d := range s.deployments {
+		if projectId == "" || d.projectId == projectId {
+			matching = append(matching, d)
+		}
+	}
+
+	deployments, pagination := page(r, matching, func(d *deploymentRecord) int64 { return d.deployment.CreatedAt })
+	response := schemas.DeploymentListResponse{Deployments: []schemas.DeploymentResponse{}, Pagination: pagination}
+	for _, d := range deployments {
+		response.Deployments = append(response.Deployments, d.deployment)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) productionDeployment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.projectOrNotFound(w, r)
+	if p == nil {
+		return
+	}
+
+	for i := len(s.deployments) - 1; i >= 0; i-- {
+		d := s.deployments[i]
+		if d.projectId != p.project.ID || d.deployment.Target != "production" || !d.deployment.ReadyState.IsSuccess() {
+			continue
+		}
+		response := schemas.CurrentDeploymentResponse{
+			Deployment: schemas.CurrentDeployment{
+				CreatedAt:          d.deployment.CreatedAt,
+				DeploymentHostname: d.deployment.Url,
+				Id:                 d.deployment.Id,
+				Name:               d.name,
+				ReadyState:         d.deployment.ReadyState,
+				TeamId:             d.teamId,
+				Url:                d.deployment.Url,
+				ProjectId:          d.projectId,
+				Target:             d.deployment.Target,
+			},
+		}
+		if len(p.domains) > 0 {
+			domain := p.domains[0]
+			response.Domain = schemas.CurrentDomain{
+				Name:      domain.Name,
+				ApexName:  domain.ApexName,
+				ProjectId: domain.ProjectID,
+				UpdatedAt: domain.UpdatedAt,
+				CreatedAt: domain.CreatedAt,
+				Verified:  domain.Verified,
+			}
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+	writeError(w, http.StatusNotFound, "not_found", "No production deployment found")
+}
+
+func (s *Server) deploymentEvents(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	d := s.deployment(r.PathValue("id"))
+	s.mu.Unlock()
+	if d == nil {
+		writeError(w, http.StatusNotFound, "not_found", "Deployment not found")
+		return
+	}
+	if follow := r.URL.Query().Get("follow"); follow == "1" || follow == "true" {
+		s.followDeploymentEvents(w, r, d)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	since, _ := strconv.ParseInt(query.Get("since"), 10, 64)
+	until, _ := strconv.ParseInt(query.Get("until"), 10, 64)
+	events := slices.DeleteFunc(slices.Clone(d.events), func(event schemas.DeployLogsResponse) bool {
+		return (since != 0 && event.Created < since) || (until != 0 && event.Created > until)
+	})
+	slices.SortStableFunc(events, func(a, b schemas.DeployLogsResponse) int {
+		return cmp.Compare(a.Created, b.Created)
+	})
+	if query.Get("direction") == "backward" {
+		slices.Reverse(events)
+	}
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && limit < len(events) {
+		events = events[:limit]
+	}
+	writeJSON(w, http.StatusOK, events)
+}
+
+// followDeploymentEvents streams the events of d as newline-delimited JSON until it reaches a final state.
+// The deployment goes through its scripted states while it is followed, as if it was building.
+func (s *Server) followDeploymentEvents(w http.ResponseWriter, r *http.Request, d *deploymentRecord) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+
+	sent := 0
+	for {
+		s.mu.Lock()
+		if sent == len(d.events) {
+			s.advance(d)
+		}
+		events := slices.Clone(d.events[sent:])
+		done := d.deployment.ReadyState.IsTerminal()
+		changed := s.changed
+		s.mu.Unlock()
+
+		for _, event := range events {
+			if err := enc.Encode(event); err != nil {
+				return
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		sent += len(events)
+
+		switch {
+		case done:
+			return
+		case len(events) > 0:
+			continue
+		}
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
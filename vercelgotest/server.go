@@ -0,0 +1,343 @@
+// Package vercelgotest provides an in-memory fake of the Vercel REST API for testing code built on vercelgo.
+//
+// The fake implements the endpoints called by vercelgo.VercelClient (teams, projects, project domains,
+// domain configuration and verification, file uploads, deployments, production deployments and
+// deployment events), simulates deployment state transitions and can be scripted to fail requests.
+package vercelgotest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GitDocAI/vercelgo"
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+// DefaultToken is the bearer token accepted by a Server unless Token is changed.
+const DefaultToken = "vercelgotest-token"
+
+// DefaultDeploymentStates are the ready states a new deployment goes through, one per status request.
+var DefaultDeploymentStates = []schemas.ReadyState{schemas.ReadyStateQueued, schemas.ReadyStateBuilding, schemas.ReadyStateReady}
+
+// Server is an in-memory fake of the Vercel API served over HTTP.
+// All methods are safe for concurrent use; Token and DeploymentStates should be set before issuing requests.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// Token is the bearer token requests must carry. An empty Token accepts any request.
+	Token string
+	// DeploymentStates are the ready states new deployments go through.
+	// Every GET of a deployment advances it to the next state, and following its events
+	// goes through all of them; the last state is kept.
+	DeploymentStates []schemas.ReadyState
+
+	lastTime    int64
+	nextID      int
+	teams       []*teamRecord
+	projects    []*projectRecord
+	configs     map[string]schemas.DomainConfigInfo
+	files       map[string]int64
+	deployments []*deploymentRecord
+	failures    []*Failure
+	requests    []Request
+	// changed is closed and replaced whenever deployment events are added, to wake up followers.
+	changed chan struct{}
+}
+
+// Failure scripts an error response for matching requests.
+type Failure struct {
+	// Method matches the request method; empty matches any method.
+	Method string
+	// Path matches the request path using path.Match syntax; empty matches any path.
+	Path string
+	// Status is the HTTP status code of the response; zero means 500.
+	Status int
+	// Code and Message fill the "error" object of the response body.
+	Code    string
+	Message string
+	// Header is added to the response, e.g. Retry-After.
+	Header http.Header
+	// Times is the number of requests to fail; zero fails a single request.
+	Times int
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	// Body is the request body. It is nil for file uploads, whose content is not kept.
+	Body []byte
+}
+
+type teamRecord struct {
+	team      schemas.Team
+	createdAt int64
+}
+
+type projectRecord struct {
+	project   schemas.Project
+	teamId    string
+	settings  schemas.CreateProjectRequest
+	paused    bool
+	createdAt int64
+	domains   []*schemas.DomainInfo
+}
+
+type deploymentRecord struct {
+	deployment schemas.DeploymentResponse
+	name       string
+	projectId  string
+	teamId     string
+	states     []schemas.ReadyState
+	events     []schemas.DeployLogsResponse
+	// errorCode, errorMessage and errorStep are reported once the deployment is in the ERROR state.
+	errorCode    string
+	errorMessage string
+	errorStep    string
+}
+
+// NewServer starts a fake Vercel API server. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		Token:            DefaultToken,
+		DeploymentStates: DefaultDeploymentStates,
+		configs:          map[string]schemas.DomainConfigInfo{},
+		files:            map[string]int64{},
+		changed:          make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Client returns a VercelClient talking to s. Retries use no backoff so scripted failures resolve quickly.
+// opts are applied after the defaults.
+func (s *Server) Client(opts ...vercelgo.Option) *vercelgo.VercelClient {
+	s.mu.Lock()
+	token := s.Token
+	s.mu.Unlock()
+
+	retryPolicy := vercelgo.DefaultRetryPolicy()
+	retryPolicy.InitialBackoff = 0
+	retryPolicy.MaxBackoff = 0
+
+	defaults := []vercelgo.Option{
+		vercelgo.WithToken(token),
+		vercelgo.WithBaseURL(s.URL),
+		vercelgo.WithHTTPClient(s.Server.Client()),
+		vercelgo.WithRetryPolicy(retryPolicy),
+	}
+	return vercelgo.NewClient(append(defaults, opts...)...)
+}
+
+// Fail scripts f for the next matching requests. Failures are matched in the order they were added.
+func (s *Server) Fail(f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f.Times == 0 {
+		f.Times = 1
+	}
+	if f.Status == 0 {
+		f.Status = http.StatusInternalServerError
+	}
+	s.failures = append(s.failures, &f)
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// AddTeam stores team, assigning an ID when it has none, and returns it.
+func (s *Server) AddTeam(team schemas.Team) schemas.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTeam(team)
+}
+
+// AddProject stores project under teamId, assigning an ID when it has none, and returns it.
+func (s *Server) AddProject(teamId string, project schemas.Project) schemas.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addProject(teamId, project, schemas.CreateProjectRequest{Name: project.Name})
+}
+
+// AddDomain attaches domain to the project identified by projectIdOrName.
+// It reports false when the project does not exist.
+func (s *Server) AddDomain(projectIdOrName string, domain schemas.DomainInfo) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.project(projectIdOrName)
+	if p == nil {
+		return false
+	}
+	s.addDomain(p, domain)
+	return true
+}
+
+// SetDomainConfig sets the configuration returned for domain.
+func (s *Server) SetDomainConfig(domain string, config schemas.DomainConfigInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configs[domain] = config
+}
+
+// AddDeployment stores deployment for projectId under teamId with the given state sequence
+// (DeploymentStates when empty), assigning an ID when it has none, and returns it.
+func (s *Server) AddDeployment(teamId, projectId string, deployment schemas.DeploymentResponse, states ...schemas.ReadyState) schemas.DeploymentResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addDeployment(teamId, projectId, deployment, states).deployment
+}
+
+// SetDeploymentState forces the ready state of a deployment, stopping any further transitions.
+// It reports false when the deployment does not exist.
+func (s *Server) SetDeploymentState(deploymentId string, readyState schemas.ReadyState) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(deploymentId)
+	if d == nil {
+		return false
+	}
+	d.states = nil
+	s.setState(d, readyState)
+	return true
+}
+
+// FailDeployment moves a deployment to the ERROR state, reporting the given error code,
+// message and failing build step. It reports false when the deployment does not exist.
+func (s *Server) FailDeployment(deploymentId, code, message, step string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(deploymentId)
+	if d == nil {
+		return false
+	}
+	d.states = nil
+	d.errorCode, d.errorMessage, d.errorStep = code, message, step
+	s.setState(d, schemas.ReadyStateError)
+	return true
+}
+
+// Deployment returns the current state of a deployment.
+func (s *Server) Deployment(deploymentId string) (schemas.DeploymentResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(deploymentId)
+	if d == nil {
+		return schemas.DeploymentResponse{}, false
+	}
+	return d.deployment, true
+}
+
+// Deployments returns the deployments of a project, newest first.
+func (s *Server) Deployments(projectId string) []schemas.DeploymentResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deployments []schemas.DeploymentResponse
+	for i := len(s.deployments) - 1; i >= 0; i-- {
+		if s.deployments[i].projectId == projectId {
+			deployments = append(deployments, s.deployments[i].deployment)
+		}
+	}
+	return deployments
+}
+
+// AddEvents appends build events to a deployment. Events without a creation date are dated now
+// and the deployment ID and date of their payload default to the ones of the event.
+// It reports false when the deployment does not exist.
+func (s *Server) AddEvents(deploymentId string, events ...schemas.DeployLogsResponse) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deployment(deploymentId)
+	if d == nil {
+		return false
+	}
+	for _, event := range events {
+		if event.Created == 0 {
+			event.Created = s.now()
+		}
+		if event.Payload.DeploymentId == "" {
+			event.Payload.DeploymentId = d.deployment.Id
+		}
+		if event.Payload.Date == 0 {
+			event.Payload.Date = event.Created
+		}
+		d.events = append(d.events, event)
+	}
+	s.notify()
+	return true
+}
+
+// File returns the size of the content uploaded for sha.
+// The server only keeps the digest and size of uploaded files, not their content.
+func (s *Server) File(sha string) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	size, ok := s.files[sha]
+	return size, ok
+}
+
+// notify wakes up the requests following deployment events. s.mu must be held.
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// now returns a strictly increasing timestamp in milliseconds, used for creation dates and cursors.
+func (s *Server) now() int64 {
+	t := time.Now().UnixMilli()
+	if t <= s.lastTime {
+		t = s.lastTime + 1
+	}
+	s.lastTime = t
+	return t
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return prefix + "_" + strconv.Itoa(s.nextID)
+}
+
+// failure returns the scripted failure matching r, consuming one of its occurrences.
+func (s *Server) failure(r *http.Request) *Failure {
+	for i, f := range s.failures {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, r.URL.Path); !ok {
+				continue
+			}
+		}
+		f.Times--
+		if f.Times <= 0 {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+		}
+		return f
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeErrorBody(w, status, map[string]any{"code": code, "message": message})
+}
+
+func writeErrorBody(w http.ResponseWriter, status int, body map[string]any) {
+	writeJSON(w, status, map[string]any{"error": body})
+}
@@ -0,0 +1,152 @@
+package vercelgo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GitDocAI/vercelgo/schemas"
+)
+
+const (
+	// DefaultWaitInitialInterval is the delay before the second status poll of WaitDeployment.
+	DefaultWaitInitialInterval = time.Second
+	// DefaultWaitMaxInterval is the longest delay between two status polls of WaitDeployment.
+	DefaultWaitMaxInterval = 10 * time.Second
+)
+
+// WaitOption configures WaitDeployment.
+type WaitOption func(*waitConfig)
+
+type waitConfig struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	timeout         time.Duration
+	onStateChange   func(previous schemas.ReadyState, status *schemas.DeploymentStatus)
+	followEvents    bool
+	onEvent         func(schemas.DeployLogsResponse)
+}
+
+func newWaitConfig(opts []WaitOption) *waitConfig {
+	cfg := &waitConfig{
+		initialInterval: DefaultWaitInitialInterval,
+		maxInterval:     DefaultWaitMaxInterval,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithPollInterval sets the delay between two status polls: it starts at initial and doubles
+// after every poll up to maximum, going back to initial whenever the ready state changes.
+func WithPollInterval(initial, maximum time.Duration) WaitOption {
+	return func(cfg *waitConfig) {
+		if initial > 0 {
+			cfg.initialInterval = initial
+			cfg.maxInterval = max(maximum, initial)
+		}
+	}
+}
+
+// WithWaitTimeout stops waiting after timeout. Without it, WaitDeployment waits as long as its context allows.
+func WithWaitTimeout(timeout time.Duration) WaitOption {
+	return func(cfg *waitConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithStateChange calls fn whenever the ready state of the deployment changes, starting with
+// the first state observed, for which previous is empty.
+func WithStateChange(fn func(previous schemas.ReadyState, status *schemas.DeploymentStatus)) WaitOption {
+	return func(cfg *waitConfig) {
+		cfg.onStateChange = fn
+	}
+}
+
+// WithEventStream follows the build events of the deployment instead of polling its status while
+// it builds, passing every event to fn unless it is nil. Polling takes over once the stream ends or fails.
+func WithEventStream(fn func(schemas.DeployLogsResponse)) WaitOption {
+	return func(cfg *waitConfig) {
+		cfg.followEvents = true
+		cfg.onEvent = fn
+	}
+}
+
+// WaitDeployment waits until a deployment reaches a final state and returns its last status.
+// A deployment ending in the ERROR or CANCELED state is returned along with a *DeploymentError
+// telling why. Waiting stops with the cause of ctx once it is done.
+func (c *VercelClient) WaitDeployment(ctx context.Context, deploymentId, teamId string, opts ...WaitOption) (*schemas.DeploymentStatus, error) {
+	cfg := newWaitConfig(opts)
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, cfg.timeout,
+			fmt.Errorf("deployment monitoring timed out after %v: %w", cfg.timeout, context.DeadlineExceeded))
+		defer cancel()
+	}
+
+	var state schemas.ReadyState
+	interval := cfg.initialInterval
+	followed := !cfg.followEvents
+	for poll := 1; ; poll++ {
+		status, err := c.GetDeploymentStatusCtx(ctx, deploymentId, teamId)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, context.Cause(ctx)
+			}
+			return nil, fmt.Errorf("error checking deployment status: %w", err)
+		}
+		c.settings().logger.DebugContext(ctx, "polled deployment status", "deploymentId", deploymentId, "poll", poll, "readyState", status.ReadyState)
+
+		if status.ReadyState != state {
+			if cfg.onStateChange != nil {
+				cfg.onStateChange(state, status)
+			}
+			state = status.ReadyState
+			interval = cfg.initialInterval
+		}
+
+		switch {
+		case status.ReadyState.IsSuccess():
+			return status, nil
+		case status.ReadyState.IsTerminal():
+			return status, &DeploymentError{
+				DeploymentID: deploymentId,
+				ReadyState:   status.ReadyState,
+				Code:         status.ErrorCode,
+				Message:      status.ErrorMessage,
+				Step:         status.ErrorStep,
+			}
+		}
+
+		// The event stream lasts until the build finishes, after which the final state is polled right away.
+		if !followed {
+			followed = true
+			c.followDeploymentEvents(ctx, deploymentId, teamId, cfg.onEvent)
+			continue
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, context.Cause(ctx)
+		case <-timer.C:
+		}
+		interval = min(interval*2, cfg.maxInterval)
+	}
+}
+
+// followDeploymentEvents passes the build events of a deployment to fn until the stream ends.
+// Stream failures are only logged since WaitDeployment falls back to polling.
+func (c *VercelClient) followDeploymentEvents(ctx context.Context, deploymentId, teamId string, fn func(schemas.DeployLogsResponse)) {
+	for event, err := range c.StreamDeploymentEvents(ctx, deploymentId, teamId) {
+		if err != nil {
+			c.settings().logger.DebugContext(ctx, "deployment event stream failed", "deploymentId", deploymentId, "error", err)
+			return
+		}
+		if fn != nil {
+			fn(event)
+		}
+	}
+}